@@ -9,11 +9,14 @@ import math "math"
 import types "github.com/gogo/protobuf/types"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
-import context "golang.org/x/net/context"
-import grpc "google.golang.org/grpc"
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
 
 import strings "strings"
 import reflect "reflect"
+import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -28,6 +31,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type UpdateTodosResponse_Result_Status int32
+
+const (
+	UpdateTodosResponse_Result_STATUS_UNSPECIFIED UpdateTodosResponse_Result_Status = 0
+	UpdateTodosResponse_Result_UPDATED            UpdateTodosResponse_Result_Status = 1
+	UpdateTodosResponse_Result_NOT_FOUND          UpdateTodosResponse_Result_Status = 2
+	UpdateTodosResponse_Result_INVALID            UpdateTodosResponse_Result_Status = 3
+)
+
+var UpdateTodosResponse_Result_Status_name = map[int32]string{
+	0: "STATUS_UNSPECIFIED",
+	1: "UPDATED",
+	2: "NOT_FOUND",
+	3: "INVALID",
+}
+var UpdateTodosResponse_Result_Status_value = map[string]int32{
+	"STATUS_UNSPECIFIED": 0,
+	"UPDATED":            1,
+	"NOT_FOUND":          2,
+	"INVALID":            3,
+}
+
+func (x UpdateTodosResponse_Result_Status) String() string {
+	return proto.EnumName(UpdateTodosResponse_Result_Status_name, int32(x))
+}
+func (UpdateTodosResponse_Result_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{20, 0, 0}
+}
+
+type TimeReportRequest_GroupBy int32
+
+const (
+	TimeReportRequest_TODO TimeReportRequest_GroupBy = 0
+	TimeReportRequest_TAG  TimeReportRequest_GroupBy = 1
+	TimeReportRequest_LIST TimeReportRequest_GroupBy = 2
+)
+
+var TimeReportRequest_GroupBy_name = map[int32]string{
+	0: "TODO",
+	1: "TAG",
+	2: "LIST",
+}
+var TimeReportRequest_GroupBy_value = map[string]int32{
+	"TODO": 0,
+	"TAG":  1,
+	"LIST": 2,
+}
+
+func (x TimeReportRequest_GroupBy) String() string {
+	return proto.EnumName(TimeReportRequest_GroupBy_name, int32(x))
+}
+func (TimeReportRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{27, 0}
+}
+
+type TodoEvent_Type int32
+
+const (
+	TodoEvent_CREATED TodoEvent_Type = 0
+	TodoEvent_UPDATED TodoEvent_Type = 1
+	TodoEvent_DELETED TodoEvent_Type = 2
+)
+
+var TodoEvent_Type_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+var TodoEvent_Type_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x TodoEvent_Type) String() string {
+	return proto.EnumName(TodoEvent_Type_name, int32(x))
+}
+func (TodoEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{51, 0}
+}
+
 type Todo struct {
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -35,18 +119,40 @@ type Todo struct {
 	// @inject_tag: sql:",notnull,default:false"
 	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
 	// @inject_tag: sql:"type:timestamptz,default:now()"
-	CreatedAt *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	CreatedAt *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// @inject_tag: sql:"type:timestamptz"
-	UpdatedAt            *types.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	UpdatedAt *types.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Workflow status of the item, one of the statuses configured in gotasks.yaml.
+	// completed is derived from it and is true for terminal statuses.
+	Status string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Tags   []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Name of the list the item belongs to.
+	List string `protobuf:"bytes,9,opt,name=list,proto3" json:"list,omitempty"`
+	// Accumulated duration of the stopped timers on the item, in seconds.
+	TrackedSeconds int64 `protobuf:"varint,10,opt,name=tracked_seconds,json=trackedSeconds,proto3" json:"tracked_seconds,omitempty"`
+	// Extra attributes, validated against the schema registered for the list.
+	CustomFields map[string]string `protobuf:"bytes,11,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ID of the todo this item is a subtask of.
+	ParentId string `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// @inject_tag: sql:"type:timestamptz"
+	DueAt *types.Timestamp `protobuf:"bytes,13,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// HTML rendering of the markdown description, only set with render=html.
+	// @inject_tag: sql:"-"
+	DescriptionHtml string `protobuf:"bytes,14,opt,name=description_html,json=descriptionHtml,proto3" json:"description_html,omitempty" sql:"-"`
+	// Checked and total checkboxes of the task lists of the description.
+	// @inject_tag: sql:"-"
+	TaskProgress *TaskProgress `protobuf:"bytes,15,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty" sql:"-"`
+	// Recurrence of the item as an RFC 5545 RRULE value, such as FREQ=MONTHLY.
+	Recurrence string `protobuf:"bytes,16,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// UID of the iCalendar VTODO the item was imported from, when it is not a
+	// UUID and so could not be used as the ID.
+	IcalUid string `protobuf:"bytes,17,opt,name=ical_uid,json=icalUid,proto3" json:"ical_uid,omitempty"`
 }
 
 func (m *Todo) Reset()      { *m = Todo{} }
 func (*Todo) ProtoMessage() {}
 func (*Todo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{0}
+	return fileDescriptor_todo_16a3fb6055739238, []int{0}
 }
 func (m *Todo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,17 +181,95 @@ func (m *Todo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Todo proto.InternalMessageInfo
 
+type TaskProgress struct {
+	Done  int32 `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *TaskProgress) Reset()      { *m = TaskProgress{} }
+func (*TaskProgress) ProtoMessage() {}
+func (*TaskProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{1}
+}
+func (m *TaskProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TaskProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgress.Merge(dst, src)
+}
+func (m *TaskProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProgress proto.InternalMessageInfo
+
+type TimeEntry struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TodoId string `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// @inject_tag: sql:"type:timestamptz"
+	StartedAt *types.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unset while the timer is running.
+	// @inject_tag: sql:"type:timestamptz"
+	StoppedAt       *types.Timestamp `protobuf:"bytes,5,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	DurationSeconds int64            `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+}
+
+func (m *TimeEntry) Reset()      { *m = TimeEntry{} }
+func (*TimeEntry) ProtoMessage() {}
+func (*TimeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{2}
+}
+func (m *TimeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TimeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeEntry.Merge(dst, src)
+}
+func (m *TimeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeEntry proto.InternalMessageInfo
+
 type CreateTodoRequest struct {
-	Item                 *Todo    `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Item *Todo `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
 func (m *CreateTodoRequest) Reset()      { *m = CreateTodoRequest{} }
 func (*CreateTodoRequest) ProtoMessage() {}
 func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{1}
+	return fileDescriptor_todo_16a3fb6055739238, []int{3}
 }
 func (m *CreateTodoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,16 +299,13 @@ func (m *CreateTodoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_CreateTodoRequest proto.InternalMessageInfo
 
 type CreateTodoResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *CreateTodoResponse) Reset()      { *m = CreateTodoResponse{} }
 func (*CreateTodoResponse) ProtoMessage() {}
 func (*CreateTodoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{2}
+	return fileDescriptor_todo_16a3fb6055739238, []int{4}
 }
 func (m *CreateTodoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,16 +335,13 @@ func (m *CreateTodoResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_CreateTodoResponse proto.InternalMessageInfo
 
 type CreateTodosRequest struct {
-	Items                []*Todo  `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Items []*Todo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
 func (m *CreateTodosRequest) Reset()      { *m = CreateTodosRequest{} }
 func (*CreateTodosRequest) ProtoMessage() {}
 func (*CreateTodosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{3}
+	return fileDescriptor_todo_16a3fb6055739238, []int{5}
 }
 func (m *CreateTodosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,16 +371,13 @@ func (m *CreateTodosRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_CreateTodosRequest proto.InternalMessageInfo
 
 type CreateTodosResponse struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids" json:"ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
 func (m *CreateTodosResponse) Reset()      { *m = CreateTodosResponse{} }
 func (*CreateTodosResponse) ProtoMessage() {}
 func (*CreateTodosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{4}
+	return fileDescriptor_todo_16a3fb6055739238, []int{6}
 }
 func (m *CreateTodosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,16 +407,18 @@ func (m *CreateTodosResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_CreateTodosResponse proto.InternalMessageInfo
 
 type GetTodoRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Set to html to render the description as HTML.
+	Render string `protobuf:"bytes,2,opt,name=render,proto3" json:"render,omitempty"`
+	// Set to strong to read from the primary database, the item may
+	// otherwise be read from a replica which lags behind.
+	Consistency string `protobuf:"bytes,3,opt,name=consistency,proto3" json:"consistency,omitempty"`
 }
 
 func (m *GetTodoRequest) Reset()      { *m = GetTodoRequest{} }
 func (*GetTodoRequest) ProtoMessage() {}
 func (*GetTodoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{5}
+	return fileDescriptor_todo_16a3fb6055739238, []int{7}
 }
 func (m *GetTodoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,16 +448,13 @@ func (m *GetTodoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetTodoRequest proto.InternalMessageInfo
 
 type GetTodoResponse struct {
-	Item                 *Todo    `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Item *Todo `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
 func (m *GetTodoResponse) Reset()      { *m = GetTodoResponse{} }
 func (*GetTodoResponse) ProtoMessage() {}
 func (*GetTodoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{6}
+	return fileDescriptor_todo_16a3fb6055739238, []int{8}
 }
 func (m *GetTodoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,17 +484,28 @@ func (m *GetTodoResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetTodoResponse proto.InternalMessageInfo
 
 type ListTodoRequest struct {
-	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	NotCompleted         bool     `protobuf:"varint,2,opt,name=not_completed,json=notCompleted,proto3" json:"not_completed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Limit        int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	NotCompleted bool   `protobuf:"varint,2,opt,name=not_completed,json=notCompleted,proto3" json:"not_completed,omitempty"`
+	List         string `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	// Filters on custom fields, formatted as key=value.
+	CustomFieldFilters []string `protobuf:"bytes,4,rep,name=custom_field_filters,json=customFieldFilters,proto3" json:"custom_field_filters,omitempty"`
+	// Field to sort by, either id, title, status, created_at, updated_at or
+	// custom_fields.<key>. Prefix with - to sort in descending order.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Set to html to render the descriptions as HTML.
+	Render string `protobuf:"bytes,6,opt,name=render,proto3" json:"render,omitempty"`
+	// Set to strong to read from the primary database, the items may
+	// otherwise be read from a replica which lags behind.
+	Consistency string `protobuf:"bytes,7,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	// Only returns the items whose ID sorts after it, to page through the
+	// items with order_by=id.
+	AfterId string `protobuf:"bytes,8,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
 }
 
 func (m *ListTodoRequest) Reset()      { *m = ListTodoRequest{} }
 func (*ListTodoRequest) ProtoMessage() {}
 func (*ListTodoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{7}
+	return fileDescriptor_todo_16a3fb6055739238, []int{9}
 }
 func (m *ListTodoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,16 +535,13 @@ func (m *ListTodoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_ListTodoRequest proto.InternalMessageInfo
 
 type ListTodoResponse struct {
-	Items                []*Todo  `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Items []*Todo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
 func (m *ListTodoResponse) Reset()      { *m = ListTodoResponse{} }
 func (*ListTodoResponse) ProtoMessage() {}
 func (*ListTodoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{8}
+	return fileDescriptor_todo_16a3fb6055739238, []int{10}
 }
 func (m *ListTodoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,16 +571,13 @@ func (m *ListTodoResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ListTodoResponse proto.InternalMessageInfo
 
 type DeleteTodoRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *DeleteTodoRequest) Reset()      { *m = DeleteTodoRequest{} }
 func (*DeleteTodoRequest) ProtoMessage() {}
 func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{9}
+	return fileDescriptor_todo_16a3fb6055739238, []int{11}
 }
 func (m *DeleteTodoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,15 +607,12 @@ func (m *DeleteTodoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteTodoRequest proto.InternalMessageInfo
 
 type DeleteTodoResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeleteTodoResponse) Reset()      { *m = DeleteTodoResponse{} }
 func (*DeleteTodoResponse) ProtoMessage() {}
 func (*DeleteTodoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{10}
+	return fileDescriptor_todo_16a3fb6055739238, []int{12}
 }
 func (m *DeleteTodoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,17 +641,167 @@ func (m *DeleteTodoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteTodoResponse proto.InternalMessageInfo
 
+type DeleteTodosRequest struct {
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *DeleteTodosRequest) Reset()      { *m = DeleteTodosRequest{} }
+func (*DeleteTodosRequest) ProtoMessage() {}
+func (*DeleteTodosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{13}
+}
+func (m *DeleteTodosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteTodosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodosRequest.Merge(dst, src)
+}
+func (m *DeleteTodosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTodosRequest proto.InternalMessageInfo
+
+type DeleteTodosResponse struct {
+	Deleted int32 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *DeleteTodosResponse) Reset()      { *m = DeleteTodosResponse{} }
+func (*DeleteTodosResponse) ProtoMessage() {}
+func (*DeleteTodosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{14}
+}
+func (m *DeleteTodosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteTodosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodosResponse.Merge(dst, src)
+}
+func (m *DeleteTodosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTodosResponse proto.InternalMessageInfo
+
+type DeleteTodosByFilterRequest struct {
+	OnlyCompleted bool   `protobuf:"varint,1,opt,name=only_completed,json=onlyCompleted,proto3" json:"only_completed,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	List          string `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	// Filters on custom fields, formatted as key=value.
+	CustomFieldFilters []string `protobuf:"bytes,4,rep,name=custom_field_filters,json=customFieldFilters,proto3" json:"custom_field_filters,omitempty"`
+	// Must be set to delete the matching items.
+	Confirm bool `protobuf:"varint,5,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	// Only count the matching items without deleting them.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *DeleteTodosByFilterRequest) Reset()      { *m = DeleteTodosByFilterRequest{} }
+func (*DeleteTodosByFilterRequest) ProtoMessage() {}
+func (*DeleteTodosByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{15}
+}
+func (m *DeleteTodosByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodosByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodosByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteTodosByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodosByFilterRequest.Merge(dst, src)
+}
+func (m *DeleteTodosByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodosByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodosByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTodosByFilterRequest proto.InternalMessageInfo
+
+type DeleteTodosByFilterResponse struct {
+	// Number of deleted items, or of matching items for a dry run.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DeleteTodosByFilterResponse) Reset()      { *m = DeleteTodosByFilterResponse{} }
+func (*DeleteTodosByFilterResponse) ProtoMessage() {}
+func (*DeleteTodosByFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{16}
+}
+func (m *DeleteTodosByFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodosByFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodosByFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeleteTodosByFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodosByFilterResponse.Merge(dst, src)
+}
+func (m *DeleteTodosByFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodosByFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodosByFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTodosByFilterResponse proto.InternalMessageInfo
+
 type UpdateTodoRequest struct {
-	Item                 *Todo    `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Item *Todo `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
 func (m *UpdateTodoRequest) Reset()      { *m = UpdateTodoRequest{} }
 func (*UpdateTodoRequest) ProtoMessage() {}
 func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{11}
+	return fileDescriptor_todo_16a3fb6055739238, []int{17}
 }
 func (m *UpdateTodoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,15 +831,12 @@ func (m *UpdateTodoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateTodoRequest proto.InternalMessageInfo
 
 type UpdateTodoResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateTodoResponse) Reset()      { *m = UpdateTodoResponse{} }
 func (*UpdateTodoResponse) ProtoMessage() {}
 func (*UpdateTodoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{12}
+	return fileDescriptor_todo_16a3fb6055739238, []int{18}
 }
 func (m *UpdateTodoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,16 +866,15 @@ func (m *UpdateTodoResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateTodoResponse proto.InternalMessageInfo
 
 type UpdateTodosRequest struct {
-	Items                []*Todo  `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Items []*Todo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Fails the whole batch without updating anything if any item fails.
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
 func (m *UpdateTodosRequest) Reset()      { *m = UpdateTodosRequest{} }
 func (*UpdateTodosRequest) ProtoMessage() {}
 func (*UpdateTodosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{13}
+	return fileDescriptor_todo_16a3fb6055739238, []int{19}
 }
 func (m *UpdateTodosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,15 +904,14 @@ func (m *UpdateTodosRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateTodosRequest proto.InternalMessageInfo
 
 type UpdateTodosResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Results of the items, in the order of the request.
+	Results []*UpdateTodosResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (m *UpdateTodosResponse) Reset()      { *m = UpdateTodosResponse{} }
 func (*UpdateTodosResponse) ProtoMessage() {}
 func (*UpdateTodosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_todo_8504e40ec3c575fb, []int{14}
+	return fileDescriptor_todo_16a3fb6055739238, []int{20}
 }
 func (m *UpdateTodosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,1184 +940,11552 @@ func (m *UpdateTodosResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateTodosResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Todo)(nil), "todo.v1.Todo")
-	proto.RegisterType((*CreateTodoRequest)(nil), "todo.v1.CreateTodoRequest")
-	proto.RegisterType((*CreateTodoResponse)(nil), "todo.v1.CreateTodoResponse")
-	proto.RegisterType((*CreateTodosRequest)(nil), "todo.v1.CreateTodosRequest")
-	proto.RegisterType((*CreateTodosResponse)(nil), "todo.v1.CreateTodosResponse")
-	proto.RegisterType((*GetTodoRequest)(nil), "todo.v1.GetTodoRequest")
-	proto.RegisterType((*GetTodoResponse)(nil), "todo.v1.GetTodoResponse")
-	proto.RegisterType((*ListTodoRequest)(nil), "todo.v1.ListTodoRequest")
-	proto.RegisterType((*ListTodoResponse)(nil), "todo.v1.ListTodoResponse")
-	proto.RegisterType((*DeleteTodoRequest)(nil), "todo.v1.DeleteTodoRequest")
-	proto.RegisterType((*DeleteTodoResponse)(nil), "todo.v1.DeleteTodoResponse")
-	proto.RegisterType((*UpdateTodoRequest)(nil), "todo.v1.UpdateTodoRequest")
-	proto.RegisterType((*UpdateTodoResponse)(nil), "todo.v1.UpdateTodoResponse")
-	proto.RegisterType((*UpdateTodosRequest)(nil), "todo.v1.UpdateTodosRequest")
-	proto.RegisterType((*UpdateTodosResponse)(nil), "todo.v1.UpdateTodosResponse")
+type UpdateTodosResponse_Result struct {
+	Id     string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status UpdateTodosResponse_Result_Status `protobuf:"varint,2,opt,name=status,proto3,enum=todo.v1.UpdateTodosResponse_Result_Status" json:"status,omitempty"`
+	// Reason of the failure of an invalid item.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *UpdateTodosResponse_Result) Reset()      { *m = UpdateTodosResponse_Result{} }
+func (*UpdateTodosResponse_Result) ProtoMessage() {}
+func (*UpdateTodosResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{20, 0}
+}
+func (m *UpdateTodosResponse_Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTodosResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTodosResponse_Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UpdateTodosResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTodosResponse_Result.Merge(dst, src)
+}
+func (m *UpdateTodosResponse_Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTodosResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTodosResponse_Result.DiscardUnknown(m)
+}
 
-// Client API for TodoService service
+var xxx_messageInfo_UpdateTodosResponse_Result proto.InternalMessageInfo
 
-type TodoServiceClient interface {
-	CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error)
-	// Bulk version of CreateTodo
-	CreateTodos(ctx context.Context, in *CreateTodosRequest, opts ...grpc.CallOption) (*CreateTodosResponse, error)
-	GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*GetTodoResponse, error)
-	ListTodo(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error)
-	DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error)
-	UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*UpdateTodoResponse, error)
-	UpdateTodos(ctx context.Context, in *UpdateTodosRequest, opts ...grpc.CallOption) (*UpdateTodosResponse, error)
+type StartTimerRequest struct {
+	TodoId string `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-type todoServiceClient struct {
-	cc *grpc.ClientConn
+func (m *StartTimerRequest) Reset()      { *m = StartTimerRequest{} }
+func (*StartTimerRequest) ProtoMessage() {}
+func (*StartTimerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{21}
 }
-
-func NewTodoServiceClient(cc *grpc.ClientConn) TodoServiceClient {
-	return &todoServiceClient{cc}
+func (m *StartTimerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *todoServiceClient) CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error) {
-	out := new(CreateTodoResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CreateTodo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StartTimerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartTimerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *todoServiceClient) CreateTodos(ctx context.Context, in *CreateTodosRequest, opts ...grpc.CallOption) (*CreateTodosResponse, error) {
-	out := new(CreateTodosResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CreateTodos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (dst *StartTimerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTimerRequest.Merge(dst, src)
 }
-
-func (c *todoServiceClient) GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*GetTodoResponse, error) {
-	out := new(GetTodoResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/GetTodo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StartTimerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartTimerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTimerRequest.DiscardUnknown(m)
 }
 
-func (c *todoServiceClient) ListTodo(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error) {
-	out := new(ListTodoResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/ListTodo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_StartTimerRequest proto.InternalMessageInfo
+
+type StartTimerResponse struct {
+	Entry *TimeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (c *todoServiceClient) DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error) {
-	out := new(DeleteTodoResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/DeleteTodo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StartTimerResponse) Reset()      { *m = StartTimerResponse{} }
+func (*StartTimerResponse) ProtoMessage() {}
+func (*StartTimerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{22}
+}
+func (m *StartTimerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartTimerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartTimerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *StartTimerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTimerResponse.Merge(dst, src)
+}
+func (m *StartTimerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartTimerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTimerResponse.DiscardUnknown(m)
 }
 
-func (c *todoServiceClient) UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*UpdateTodoResponse, error) {
-	out := new(UpdateTodoResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/UpdateTodo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_StartTimerResponse proto.InternalMessageInfo
+
+type StopTimerRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (c *todoServiceClient) UpdateTodos(ctx context.Context, in *UpdateTodosRequest, opts ...grpc.CallOption) (*UpdateTodosResponse, error) {
-	out := new(UpdateTodosResponse)
-	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/UpdateTodos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StopTimerRequest) Reset()      { *m = StopTimerRequest{} }
+func (*StopTimerRequest) ProtoMessage() {}
+func (*StopTimerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{23}
+}
+func (m *StopTimerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopTimerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopTimerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *StopTimerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopTimerRequest.Merge(dst, src)
+}
+func (m *StopTimerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopTimerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopTimerRequest.DiscardUnknown(m)
 }
 
-// Server API for TodoService service
+var xxx_messageInfo_StopTimerRequest proto.InternalMessageInfo
 
-type TodoServiceServer interface {
-	CreateTodo(context.Context, *CreateTodoRequest) (*CreateTodoResponse, error)
-	// Bulk version of CreateTodo
-	CreateTodos(context.Context, *CreateTodosRequest) (*CreateTodosResponse, error)
-	GetTodo(context.Context, *GetTodoRequest) (*GetTodoResponse, error)
-	ListTodo(context.Context, *ListTodoRequest) (*ListTodoResponse, error)
-	DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error)
-	UpdateTodo(context.Context, *UpdateTodoRequest) (*UpdateTodoResponse, error)
-	UpdateTodos(context.Context, *UpdateTodosRequest) (*UpdateTodosResponse, error)
+type StopTimerResponse struct {
+	Entry *TimeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func RegisterTodoServiceServer(s *grpc.Server, srv TodoServiceServer) {
-	s.RegisterService(&_TodoService_serviceDesc, srv)
+func (m *StopTimerResponse) Reset()      { *m = StopTimerResponse{} }
+func (*StopTimerResponse) ProtoMessage() {}
+func (*StopTimerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{24}
 }
-
-func _TodoService_CreateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTodoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).CreateTodo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/CreateTodo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).CreateTodo(ctx, req.(*CreateTodoRequest))
+func (m *StopTimerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopTimerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopTimerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *StopTimerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopTimerResponse.Merge(dst, src)
+}
+func (m *StopTimerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopTimerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopTimerResponse.DiscardUnknown(m)
 }
 
-func _TodoService_CreateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTodosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).CreateTodos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/CreateTodos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).CreateTodos(ctx, req.(*CreateTodosRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_StopTimerResponse proto.InternalMessageInfo
+
+type ListTimeEntriesRequest struct {
+	TodoId string `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
 }
 
-func _TodoService_GetTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTodoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).GetTodo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/GetTodo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).GetTodo(ctx, req.(*GetTodoRequest))
+func (m *ListTimeEntriesRequest) Reset()      { *m = ListTimeEntriesRequest{} }
+func (*ListTimeEntriesRequest) ProtoMessage() {}
+func (*ListTimeEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{25}
+}
+func (m *ListTimeEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTimeEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTimeEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ListTimeEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTimeEntriesRequest.Merge(dst, src)
+}
+func (m *ListTimeEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTimeEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTimeEntriesRequest.DiscardUnknown(m)
 }
 
-func _TodoService_ListTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTodoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).ListTodo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/ListTodo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).ListTodo(ctx, req.(*ListTodoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_ListTimeEntriesRequest proto.InternalMessageInfo
+
+type ListTimeEntriesResponse struct {
+	Entries []*TimeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func _TodoService_DeleteTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTodoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).DeleteTodo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/DeleteTodo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).DeleteTodo(ctx, req.(*DeleteTodoRequest))
+func (m *ListTimeEntriesResponse) Reset()      { *m = ListTimeEntriesResponse{} }
+func (*ListTimeEntriesResponse) ProtoMessage() {}
+func (*ListTimeEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{26}
+}
+func (m *ListTimeEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTimeEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTimeEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *ListTimeEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTimeEntriesResponse.Merge(dst, src)
+}
+func (m *ListTimeEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTimeEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTimeEntriesResponse.DiscardUnknown(m)
 }
 
-func _TodoService_UpdateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTodoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTodo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/UpdateTodo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTodo(ctx, req.(*UpdateTodoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_ListTimeEntriesResponse proto.InternalMessageInfo
+
+type TimeReportRequest struct {
+	From    *types.Timestamp          `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *types.Timestamp          `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy TimeReportRequest_GroupBy `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=todo.v1.TimeReportRequest_GroupBy" json:"group_by,omitempty"`
 }
 
-func _TodoService_UpdateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTodosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTodos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/todo.v1.TodoService/UpdateTodos",
+func (m *TimeReportRequest) Reset()      { *m = TimeReportRequest{} }
+func (*TimeReportRequest) ProtoMessage() {}
+func (*TimeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{27}
+}
+func (m *TimeReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTodos(ctx, req.(*UpdateTodosRequest))
+}
+func (dst *TimeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeReportRequest.Merge(dst, src)
+}
+func (m *TimeReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeReportRequest proto.InternalMessageInfo
+
+type TimeReportResponse struct {
+	Rows []*TimeReportResponse_Row `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *TimeReportResponse) Reset()      { *m = TimeReportResponse{} }
+func (*TimeReportResponse) ProtoMessage() {}
+func (*TimeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{28}
+}
+func (m *TimeReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *TimeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeReportResponse.Merge(dst, src)
+}
+func (m *TimeReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeReportResponse.DiscardUnknown(m)
 }
 
-var _TodoService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "todo.v1.TodoService",
-	HandlerType: (*TodoServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTodo",
-			Handler:    _TodoService_CreateTodo_Handler,
-		},
-		{
-			MethodName: "CreateTodos",
-			Handler:    _TodoService_CreateTodos_Handler,
-		},
-		{
-			MethodName: "GetTodo",
-			Handler:    _TodoService_GetTodo_Handler,
-		},
-		{
-			MethodName: "ListTodo",
-			Handler:    _TodoService_ListTodo_Handler,
-		},
-		{
-			MethodName: "DeleteTodo",
-			Handler:    _TodoService_DeleteTodo_Handler,
-		},
-		{
-			MethodName: "UpdateTodo",
-			Handler:    _TodoService_UpdateTodo_Handler,
-		},
-		{
-			MethodName: "UpdateTodos",
-			Handler:    _TodoService_UpdateTodos_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "github.com/gofunct/gotasks/api/todo/v1/todo.proto",
-}
+var xxx_messageInfo_TimeReportResponse proto.InternalMessageInfo
 
-func (m *Todo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TimeReportResponse_Row struct {
+	// Todo ID, tag or list name depending on the grouping.
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Seconds int64  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
 }
 
-func (m *Todo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
-	}
-	if m.Completed {
-		dAtA[i] = 0x20
-		i++
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TimeReportResponse_Row) Reset()      { *m = TimeReportResponse_Row{} }
+func (*TimeReportResponse_Row) ProtoMessage() {}
+func (*TimeReportResponse_Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{28, 0}
+}
+func (m *TimeReportResponse_Row) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeReportResponse_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeReportResponse_Row.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.CreatedAt != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.CreatedAt.Size()))
-		n1, err := m.CreatedAt.MarshalTo(dAtA[i:])
+}
+func (dst *TimeReportResponse_Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeReportResponse_Row.Merge(dst, src)
+}
+func (m *TimeReportResponse_Row) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeReportResponse_Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeReportResponse_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeReportResponse_Row proto.InternalMessageInfo
+
+type CustomFieldSchema struct {
+	List string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	// JSON Schema document the custom fields of the items are validated against.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// @inject_tag: sql:"type:timestamptz"
+	UpdatedAt *types.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *CustomFieldSchema) Reset()      { *m = CustomFieldSchema{} }
+func (*CustomFieldSchema) ProtoMessage() {}
+func (*CustomFieldSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{29}
+}
+func (m *CustomFieldSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomFieldSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomFieldSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if m.UpdatedAt != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.UpdatedAt.Size()))
-		n2, err := m.UpdatedAt.MarshalTo(dAtA[i:])
+}
+func (dst *CustomFieldSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomFieldSchema.Merge(dst, src)
+}
+func (m *CustomFieldSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomFieldSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomFieldSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomFieldSchema proto.InternalMessageInfo
+
+type RegisterCustomFieldSchemaRequest struct {
+	List   string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *RegisterCustomFieldSchemaRequest) Reset()      { *m = RegisterCustomFieldSchemaRequest{} }
+func (*RegisterCustomFieldSchemaRequest) ProtoMessage() {}
+func (*RegisterCustomFieldSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{30}
+}
+func (m *RegisterCustomFieldSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterCustomFieldSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCustomFieldSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *RegisterCustomFieldSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCustomFieldSchemaRequest.Merge(dst, src)
+}
+func (m *RegisterCustomFieldSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterCustomFieldSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCustomFieldSchemaRequest.DiscardUnknown(m)
 }
 
-func (m *CreateTodoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_RegisterCustomFieldSchemaRequest proto.InternalMessageInfo
+
+type RegisterCustomFieldSchemaResponse struct {
 }
 
-func (m *CreateTodoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
-		n3, err := m.Item.MarshalTo(dAtA[i:])
+func (m *RegisterCustomFieldSchemaResponse) Reset()      { *m = RegisterCustomFieldSchemaResponse{} }
+func (*RegisterCustomFieldSchemaResponse) ProtoMessage() {}
+func (*RegisterCustomFieldSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{31}
+}
+func (m *RegisterCustomFieldSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterCustomFieldSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCustomFieldSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *RegisterCustomFieldSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCustomFieldSchemaResponse.Merge(dst, src)
+}
+func (m *RegisterCustomFieldSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterCustomFieldSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCustomFieldSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *CreateTodoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_RegisterCustomFieldSchemaResponse proto.InternalMessageInfo
+
+type GetCustomFieldSchemaRequest struct {
+	List string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 }
 
-func (m *CreateTodoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetCustomFieldSchemaRequest) Reset()      { *m = GetCustomFieldSchemaRequest{} }
+func (*GetCustomFieldSchemaRequest) ProtoMessage() {}
+func (*GetCustomFieldSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{32}
+}
+func (m *GetCustomFieldSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCustomFieldSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCustomFieldSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *GetCustomFieldSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCustomFieldSchemaRequest.Merge(dst, src)
+}
+func (m *GetCustomFieldSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCustomFieldSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCustomFieldSchemaRequest.DiscardUnknown(m)
 }
 
-func (m *CreateTodosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_GetCustomFieldSchemaRequest proto.InternalMessageInfo
+
+type GetCustomFieldSchemaResponse struct {
+	Schema *CustomFieldSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (m *CreateTodosRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *GetCustomFieldSchemaResponse) Reset()      { *m = GetCustomFieldSchemaResponse{} }
+func (*GetCustomFieldSchemaResponse) ProtoMessage() {}
+func (*GetCustomFieldSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{33}
+}
+func (m *GetCustomFieldSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCustomFieldSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCustomFieldSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+}
+func (dst *GetCustomFieldSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCustomFieldSchemaResponse.Merge(dst, src)
+}
+func (m *GetCustomFieldSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCustomFieldSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCustomFieldSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *CreateTodosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_GetCustomFieldSchemaResponse proto.InternalMessageInfo
+
+type CloneTodoRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *CreateTodosResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+func (m *CloneTodoRequest) Reset()      { *m = CloneTodoRequest{} }
+func (*CloneTodoRequest) ProtoMessage() {}
+func (*CloneTodoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{34}
+}
+func (m *CloneTodoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloneTodoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloneTodoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *GetTodoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *CloneTodoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneTodoRequest.Merge(dst, src)
 }
-
-func (m *GetTodoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *CloneTodoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloneTodoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneTodoRequest.DiscardUnknown(m)
 }
 
-func (m *GetTodoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CloneTodoRequest proto.InternalMessageInfo
+
+type CloneTodoResponse struct {
+	// ID of the copy of the todo.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *GetTodoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
-		n4, err := m.Item.MarshalTo(dAtA[i:])
+func (m *CloneTodoResponse) Reset()      { *m = CloneTodoResponse{} }
+func (*CloneTodoResponse) ProtoMessage() {}
+func (*CloneTodoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{35}
+}
+func (m *CloneTodoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloneTodoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloneTodoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *CloneTodoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneTodoResponse.Merge(dst, src)
+}
+func (m *CloneTodoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloneTodoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneTodoResponse.DiscardUnknown(m)
 }
 
-func (m *ListTodoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CloneTodoResponse proto.InternalMessageInfo
+
+type TodoTemplate struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// List the todos are created in.
+	List string `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	// Root todo of the template.
+	Item *TodoTemplate_Item `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	// @inject_tag: sql:"type:timestamptz,default:now()"
+	CreatedAt *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (m *ListTodoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.Limit))
-	}
-	if m.NotCompleted {
-		dAtA[i] = 0x10
-		i++
-		if m.NotCompleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TodoTemplate) Reset()      { *m = TodoTemplate{} }
+func (*TodoTemplate) ProtoMessage() {}
+func (*TodoTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{36}
+}
+func (m *TodoTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TodoTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TodoTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *TodoTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TodoTemplate.Merge(dst, src)
+}
+func (m *TodoTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TodoTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TodoTemplate.DiscardUnknown(m)
 }
 
-func (m *ListTodoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_TodoTemplate proto.InternalMessageInfo
+
+type TodoTemplate_Item struct {
+	Title        string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tags         []string          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	CustomFields map[string]string `protobuf:"bytes,4,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Due date of the todo relative to the instantiation date, in seconds.
+	// The todo has no due date when zero.
+	DueOffsetSeconds int64                `protobuf:"varint,5,opt,name=due_offset_seconds,json=dueOffsetSeconds,proto3" json:"due_offset_seconds,omitempty"`
+	Subtasks         []*TodoTemplate_Item `protobuf:"bytes,6,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
 }
 
-func (m *ListTodoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *TodoTemplate_Item) Reset()      { *m = TodoTemplate_Item{} }
+func (*TodoTemplate_Item) ProtoMessage() {}
+func (*TodoTemplate_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{36, 0}
+}
+func (m *TodoTemplate_Item) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TodoTemplate_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TodoTemplate_Item.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *DeleteTodoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *TodoTemplate_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TodoTemplate_Item.Merge(dst, src)
 }
-
-func (m *DeleteTodoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *TodoTemplate_Item) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DeleteTodoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TodoTemplate_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_TodoTemplate_Item.DiscardUnknown(m)
 }
 
-func (m *DeleteTodoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
+var xxx_messageInfo_TodoTemplate_Item proto.InternalMessageInfo
 
-func (m *UpdateTodoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type CreateTodoTemplateRequest struct {
+	Template *TodoTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 }
 
-func (m *UpdateTodoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
-		n5, err := m.Item.MarshalTo(dAtA[i:])
+func (m *CreateTodoTemplateRequest) Reset()      { *m = CreateTodoTemplateRequest{} }
+func (*CreateTodoTemplateRequest) ProtoMessage() {}
+func (*CreateTodoTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{37}
+}
+func (m *CreateTodoTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTodoTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTodoTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *CreateTodoTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTodoTemplateRequest.Merge(dst, src)
+}
+func (m *CreateTodoTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTodoTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTodoTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateTodoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CreateTodoTemplateRequest proto.InternalMessageInfo
+
+type CreateTodoTemplateResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *UpdateTodoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (m *CreateTodoTemplateResponse) Reset()      { *m = CreateTodoTemplateResponse{} }
+func (*CreateTodoTemplateResponse) ProtoMessage() {}
+func (*CreateTodoTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{38}
 }
-
-func (m *UpdateTodosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CreateTodoTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *UpdateTodosRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *CreateTodoTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTodoTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
 }
-
-func (m *UpdateTodosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *CreateTodoTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTodoTemplateResponse.Merge(dst, src)
+}
+func (m *CreateTodoTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTodoTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTodoTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateTodosResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+var xxx_messageInfo_CreateTodoTemplateResponse proto.InternalMessageInfo
+
+type GetTodoTemplateRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func encodeVarintTodo(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *GetTodoTemplateRequest) Reset()      { *m = GetTodoTemplateRequest{} }
+func (*GetTodoTemplateRequest) ProtoMessage() {}
+func (*GetTodoTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{39}
 }
-func (m *Todo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.Completed {
-		n += 2
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetTodoTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *CreateTodoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTodoTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTodoTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *GetTodoTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTodoTemplateRequest.Merge(dst, src)
+}
+func (m *GetTodoTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTodoTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTodoTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *CreateTodoResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_GetTodoTemplateRequest proto.InternalMessageInfo
+
+type GetTodoTemplateResponse struct {
+	Template *TodoTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
 }
 
-func (m *CreateTodosRequest) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTodo(uint64(l))
+func (m *GetTodoTemplateResponse) Reset()      { *m = GetTodoTemplateResponse{} }
+func (*GetTodoTemplateResponse) ProtoMessage() {}
+func (*GetTodoTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{40}
+}
+func (m *GetTodoTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTodoTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTodoTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (dst *GetTodoTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTodoTemplateResponse.Merge(dst, src)
+}
+func (m *GetTodoTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTodoTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTodoTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *CreateTodosResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovTodo(uint64(l))
+var xxx_messageInfo_GetTodoTemplateResponse proto.InternalMessageInfo
+
+type ListTodoTemplatesRequest struct {
+}
+
+func (m *ListTodoTemplatesRequest) Reset()      { *m = ListTodoTemplatesRequest{} }
+func (*ListTodoTemplatesRequest) ProtoMessage() {}
+func (*ListTodoTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{41}
+}
+func (m *ListTodoTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTodoTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTodoTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (dst *ListTodoTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTodoTemplatesRequest.Merge(dst, src)
+}
+func (m *ListTodoTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTodoTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTodoTemplatesRequest.DiscardUnknown(m)
 }
 
-func (m *GetTodoRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_ListTodoTemplatesRequest proto.InternalMessageInfo
+
+type ListTodoTemplatesResponse struct {
+	Templates []*TodoTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 }
 
-func (m *GetTodoResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListTodoTemplatesResponse) Reset()      { *m = ListTodoTemplatesResponse{} }
+func (*ListTodoTemplatesResponse) ProtoMessage() {}
+func (*ListTodoTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{42}
+}
+func (m *ListTodoTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTodoTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTodoTemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *ListTodoTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTodoTemplatesResponse.Merge(dst, src)
+}
+func (m *ListTodoTemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTodoTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTodoTemplatesResponse.DiscardUnknown(m)
 }
 
-func (m *ListTodoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovTodo(uint64(m.Limit))
-	}
-	if m.NotCompleted {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_ListTodoTemplatesResponse proto.InternalMessageInfo
+
+type DeleteTodoTemplateRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *ListTodoResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTodo(uint64(l))
+func (m *DeleteTodoTemplateRequest) Reset()      { *m = DeleteTodoTemplateRequest{} }
+func (*DeleteTodoTemplateRequest) ProtoMessage() {}
+func (*DeleteTodoTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{43}
+}
+func (m *DeleteTodoTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodoTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodoTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (dst *DeleteTodoTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodoTemplateRequest.Merge(dst, src)
+}
+func (m *DeleteTodoTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodoTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodoTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteTodoRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_DeleteTodoTemplateRequest proto.InternalMessageInfo
+
+type DeleteTodoTemplateResponse struct {
 }
 
-func (m *DeleteTodoResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DeleteTodoTemplateResponse) Reset()      { *m = DeleteTodoTemplateResponse{} }
+func (*DeleteTodoTemplateResponse) ProtoMessage() {}
+func (*DeleteTodoTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{44}
+}
+func (m *DeleteTodoTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTodoTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTodoTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *DeleteTodoTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTodoTemplateResponse.Merge(dst, src)
+}
+func (m *DeleteTodoTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTodoTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTodoTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateTodoRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTodo(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_DeleteTodoTemplateResponse proto.InternalMessageInfo
+
+type InstantiateTemplateRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Date the due offsets are relative to, defaults to now.
+	Base *types.Timestamp `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 }
 
-func (m *UpdateTodoResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *InstantiateTemplateRequest) Reset()      { *m = InstantiateTemplateRequest{} }
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{45}
+}
+func (m *InstantiateTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantiateTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *InstantiateTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateTemplateRequest.Merge(dst, src)
+}
+func (m *InstantiateTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantiateTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateTodosRequest) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTodo(uint64(l))
+var xxx_messageInfo_InstantiateTemplateRequest proto.InternalMessageInfo
+
+type InstantiateTemplateResponse struct {
+	// IDs of the created todos, the root todo first.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *InstantiateTemplateResponse) Reset()      { *m = InstantiateTemplateResponse{} }
+func (*InstantiateTemplateResponse) ProtoMessage() {}
+func (*InstantiateTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{46}
+}
+func (m *InstantiateTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantiateTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (dst *InstantiateTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateTemplateResponse.Merge(dst, src)
+}
+func (m *InstantiateTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantiateTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateTodosResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var xxx_messageInfo_InstantiateTemplateResponse proto.InternalMessageInfo
+
+type ImportTodosRequest struct {
+	// iCalendar (RFC 5545) document.
+	Calendar string `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 }
 
-func sovTodo(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ImportTodosRequest) Reset()      { *m = ImportTodosRequest{} }
+func (*ImportTodosRequest) ProtoMessage() {}
+func (*ImportTodosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{47}
+}
+func (m *ImportTodosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportTodosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportTodosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-func sozTodo(x uint64) (n int) {
-	return sovTodo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (dst *ImportTodosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTodosRequest.Merge(dst, src)
 }
-func (this *Todo) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Todo{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
-		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ImportTodosRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *CreateTodoRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateTodoRequest{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ImportTodosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTodosRequest.DiscardUnknown(m)
 }
-func (this *CreateTodoResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateTodoResponse{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+var xxx_messageInfo_ImportTodosRequest proto.InternalMessageInfo
+
+type ImportTodosResponse struct {
+	// IDs of the imported todos, in the order of the document.
+	Ids     []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Created int32    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32    `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
 }
-func (this *CreateTodosRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateTodosRequest{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ImportTodosResponse) Reset()      { *m = ImportTodosResponse{} }
+func (*ImportTodosResponse) ProtoMessage() {}
+func (*ImportTodosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{48}
 }
-func (this *CreateTodosResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateTodosResponse{`,
-		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ImportTodosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *GetTodoRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *ImportTodosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportTodosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&GetTodoRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *GetTodoResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetTodoResponse{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (dst *ImportTodosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTodosResponse.Merge(dst, src)
 }
-func (this *ListTodoRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListTodoRequest{`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`NotCompleted:` + fmt.Sprintf("%v", this.NotCompleted) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ImportTodosResponse) XXX_Size() int {
+	return m.Size()
 }
-func (this *ListTodoResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListTodoResponse{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ImportTodosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTodosResponse.DiscardUnknown(m)
 }
-func (this *DeleteTodoRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeleteTodoRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+var xxx_messageInfo_ImportTodosResponse proto.InternalMessageInfo
+
+type QuickAddTodoRequest struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// IANA time zone the dates of the text are read in, defaults to UTC.
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Only parse the text without creating the todo.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
-func (this *DeleteTodoResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeleteTodoResponse{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *QuickAddTodoRequest) Reset()      { *m = QuickAddTodoRequest{} }
+func (*QuickAddTodoRequest) ProtoMessage() {}
+func (*QuickAddTodoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{49}
 }
-func (this *UpdateTodoRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&UpdateTodoRequest{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *QuickAddTodoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *UpdateTodoResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *QuickAddTodoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuickAddTodoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&UpdateTodoResponse{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *UpdateTodosRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&UpdateTodosRequest{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+func (dst *QuickAddTodoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuickAddTodoRequest.Merge(dst, src)
 }
-func (this *UpdateTodosResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *QuickAddTodoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuickAddTodoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuickAddTodoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuickAddTodoRequest proto.InternalMessageInfo
+
+type QuickAddTodoResponse struct {
+	// ID of the created todo, empty for a dry run.
+	Id     string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Item   *Todo                         `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Tokens []*QuickAddTodoResponse_Token `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (m *QuickAddTodoResponse) Reset()      { *m = QuickAddTodoResponse{} }
+func (*QuickAddTodoResponse) ProtoMessage() {}
+func (*QuickAddTodoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{50}
+}
+func (m *QuickAddTodoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuickAddTodoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuickAddTodoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *QuickAddTodoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuickAddTodoResponse.Merge(dst, src)
+}
+func (m *QuickAddTodoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuickAddTodoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuickAddTodoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuickAddTodoResponse proto.InternalMessageInfo
+
+type QuickAddTodoResponse_Token struct {
+	// Part of the text which was recognized.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Field of the todo it was parsed into.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *QuickAddTodoResponse_Token) Reset()      { *m = QuickAddTodoResponse_Token{} }
+func (*QuickAddTodoResponse_Token) ProtoMessage() {}
+func (*QuickAddTodoResponse_Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{50, 0}
+}
+func (m *QuickAddTodoResponse_Token) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuickAddTodoResponse_Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuickAddTodoResponse_Token.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *QuickAddTodoResponse_Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuickAddTodoResponse_Token.Merge(dst, src)
+}
+func (m *QuickAddTodoResponse_Token) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuickAddTodoResponse_Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuickAddTodoResponse_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuickAddTodoResponse_Token proto.InternalMessageInfo
+
+// Change of a todo, written to the outbox in the transaction of the change
+// and published by the relay.
+type TodoEvent struct {
+	// Sequence number of the event, increasing with the changes of a todo.
+	Id     int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TodoId string         `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	Type   TodoEvent_Type `protobuf:"varint,3,opt,name=type,proto3,enum=todo.v1.TodoEvent_Type" json:"type,omitempty"`
+	// Todo after the change, as it was before for DELETED.
+	Item      *Todo            `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	CreatedAt *types.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *TodoEvent) Reset()      { *m = TodoEvent{} }
+func (*TodoEvent) ProtoMessage() {}
+func (*TodoEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{51}
+}
+func (m *TodoEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TodoEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TodoEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TodoEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TodoEvent.Merge(dst, src)
+}
+func (m *TodoEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TodoEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TodoEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TodoEvent proto.InternalMessageInfo
+
+// SnapshotHeader starts the snapshots written by gotasks backup. It is
+// followed by the records, each prefixed with its length as a uvarint.
+type SnapshotHeader struct {
+	// Version of the snapshot format, incremented on incompatible changes.
+	FormatVersion int32 `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	// Highest migration applied to the database the snapshot was taken from.
+	SchemaVersion int64            `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	CreatedAt     *types.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// SHA-256 of the records following the header, length prefixes included.
+	Checksum []byte `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Records  int64  `protobuf:"varint,5,opt,name=records,proto3" json:"records,omitempty"`
+	// Tenant the snapshot is scoped to, empty for the whole database.
+	Tenant string `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Storage driver of the database the snapshot was taken from.
+	Driver string `protobuf:"bytes,7,opt,name=driver,proto3" json:"driver,omitempty"`
+}
+
+func (m *SnapshotHeader) Reset()      { *m = SnapshotHeader{} }
+func (*SnapshotHeader) ProtoMessage() {}
+func (*SnapshotHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{52}
+}
+func (m *SnapshotHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SnapshotHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotHeader.Merge(dst, src)
+}
+func (m *SnapshotHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotHeader proto.InternalMessageInfo
+
+// SnapshotRecord is a row of a snapshot. The time entries of a todo follow
+// it.
+type SnapshotRecord struct {
+	// Types that are valid to be assigned to Record:
+	//	*SnapshotRecord_Todo
+	//	*SnapshotRecord_TimeEntry
+	//	*SnapshotRecord_CustomFieldSchema
+	//	*SnapshotRecord_Template
+	Record isSnapshotRecord_Record `protobuf_oneof:"record"`
+}
+
+func (m *SnapshotRecord) Reset()      { *m = SnapshotRecord{} }
+func (*SnapshotRecord) ProtoMessage() {}
+func (*SnapshotRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_todo_16a3fb6055739238, []int{53}
+}
+func (m *SnapshotRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SnapshotRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotRecord.Merge(dst, src)
+}
+func (m *SnapshotRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotRecord proto.InternalMessageInfo
+
+type isSnapshotRecord_Record interface {
+	isSnapshotRecord_Record()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SnapshotRecord_Todo struct {
+	Todo *Todo `protobuf:"bytes,1,opt,name=todo,proto3,oneof"`
+}
+type SnapshotRecord_TimeEntry struct {
+	TimeEntry *TimeEntry `protobuf:"bytes,2,opt,name=time_entry,json=timeEntry,proto3,oneof"`
+}
+type SnapshotRecord_CustomFieldSchema struct {
+	CustomFieldSchema *CustomFieldSchema `protobuf:"bytes,3,opt,name=custom_field_schema,json=customFieldSchema,proto3,oneof"`
+}
+type SnapshotRecord_Template struct {
+	Template *TodoTemplate `protobuf:"bytes,4,opt,name=template,proto3,oneof"`
+}
+
+func (*SnapshotRecord_Todo) isSnapshotRecord_Record()              {}
+func (*SnapshotRecord_TimeEntry) isSnapshotRecord_Record()         {}
+func (*SnapshotRecord_CustomFieldSchema) isSnapshotRecord_Record() {}
+func (*SnapshotRecord_Template) isSnapshotRecord_Record()          {}
+
+func (m *SnapshotRecord) GetRecord() isSnapshotRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *SnapshotRecord) GetTodo() *Todo {
+	if x, ok := m.GetRecord().(*SnapshotRecord_Todo); ok {
+		return x.Todo
+	}
+	return nil
+}
+
+func (m *SnapshotRecord) GetTimeEntry() *TimeEntry {
+	if x, ok := m.GetRecord().(*SnapshotRecord_TimeEntry); ok {
+		return x.TimeEntry
+	}
+	return nil
+}
+
+func (m *SnapshotRecord) GetCustomFieldSchema() *CustomFieldSchema {
+	if x, ok := m.GetRecord().(*SnapshotRecord_CustomFieldSchema); ok {
+		return x.CustomFieldSchema
+	}
+	return nil
+}
+
+func (m *SnapshotRecord) GetTemplate() *TodoTemplate {
+	if x, ok := m.GetRecord().(*SnapshotRecord_Template); ok {
+		return x.Template
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SnapshotRecord) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SnapshotRecord_OneofMarshaler, _SnapshotRecord_OneofUnmarshaler, _SnapshotRecord_OneofSizer, []interface{}{
+		(*SnapshotRecord_Todo)(nil),
+		(*SnapshotRecord_TimeEntry)(nil),
+		(*SnapshotRecord_CustomFieldSchema)(nil),
+		(*SnapshotRecord_Template)(nil),
+	}
+}
+
+func _SnapshotRecord_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SnapshotRecord)
+	// record
+	switch x := m.Record.(type) {
+	case *SnapshotRecord_Todo:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Todo); err != nil {
+			return err
+		}
+	case *SnapshotRecord_TimeEntry:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TimeEntry); err != nil {
+			return err
+		}
+	case *SnapshotRecord_CustomFieldSchema:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CustomFieldSchema); err != nil {
+			return err
+		}
+	case *SnapshotRecord_Template:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Template); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SnapshotRecord.Record has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SnapshotRecord_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SnapshotRecord)
+	switch tag {
+	case 1: // record.todo
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Todo)
+		err := b.DecodeMessage(msg)
+		m.Record = &SnapshotRecord_Todo{msg}
+		return true, err
+	case 2: // record.time_entry
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TimeEntry)
+		err := b.DecodeMessage(msg)
+		m.Record = &SnapshotRecord_TimeEntry{msg}
+		return true, err
+	case 3: // record.custom_field_schema
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CustomFieldSchema)
+		err := b.DecodeMessage(msg)
+		m.Record = &SnapshotRecord_CustomFieldSchema{msg}
+		return true, err
+	case 4: // record.template
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TodoTemplate)
+		err := b.DecodeMessage(msg)
+		m.Record = &SnapshotRecord_Template{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SnapshotRecord_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SnapshotRecord)
+	// record
+	switch x := m.Record.(type) {
+	case *SnapshotRecord_Todo:
+		s := proto.Size(x.Todo)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SnapshotRecord_TimeEntry:
+		s := proto.Size(x.TimeEntry)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SnapshotRecord_CustomFieldSchema:
+		s := proto.Size(x.CustomFieldSchema)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SnapshotRecord_Template:
+		s := proto.Size(x.Template)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Todo)(nil), "todo.v1.Todo")
+	proto.RegisterMapType((map[string]string)(nil), "todo.v1.Todo.CustomFieldsEntry")
+	proto.RegisterType((*TaskProgress)(nil), "todo.v1.TaskProgress")
+	proto.RegisterType((*TimeEntry)(nil), "todo.v1.TimeEntry")
+	proto.RegisterType((*CreateTodoRequest)(nil), "todo.v1.CreateTodoRequest")
+	proto.RegisterType((*CreateTodoResponse)(nil), "todo.v1.CreateTodoResponse")
+	proto.RegisterType((*CreateTodosRequest)(nil), "todo.v1.CreateTodosRequest")
+	proto.RegisterType((*CreateTodosResponse)(nil), "todo.v1.CreateTodosResponse")
+	proto.RegisterType((*GetTodoRequest)(nil), "todo.v1.GetTodoRequest")
+	proto.RegisterType((*GetTodoResponse)(nil), "todo.v1.GetTodoResponse")
+	proto.RegisterType((*ListTodoRequest)(nil), "todo.v1.ListTodoRequest")
+	proto.RegisterType((*ListTodoResponse)(nil), "todo.v1.ListTodoResponse")
+	proto.RegisterType((*DeleteTodoRequest)(nil), "todo.v1.DeleteTodoRequest")
+	proto.RegisterType((*DeleteTodoResponse)(nil), "todo.v1.DeleteTodoResponse")
+	proto.RegisterType((*DeleteTodosRequest)(nil), "todo.v1.DeleteTodosRequest")
+	proto.RegisterType((*DeleteTodosResponse)(nil), "todo.v1.DeleteTodosResponse")
+	proto.RegisterType((*DeleteTodosByFilterRequest)(nil), "todo.v1.DeleteTodosByFilterRequest")
+	proto.RegisterType((*DeleteTodosByFilterResponse)(nil), "todo.v1.DeleteTodosByFilterResponse")
+	proto.RegisterType((*UpdateTodoRequest)(nil), "todo.v1.UpdateTodoRequest")
+	proto.RegisterType((*UpdateTodoResponse)(nil), "todo.v1.UpdateTodoResponse")
+	proto.RegisterType((*UpdateTodosRequest)(nil), "todo.v1.UpdateTodosRequest")
+	proto.RegisterType((*UpdateTodosResponse)(nil), "todo.v1.UpdateTodosResponse")
+	proto.RegisterType((*UpdateTodosResponse_Result)(nil), "todo.v1.UpdateTodosResponse.Result")
+	proto.RegisterType((*StartTimerRequest)(nil), "todo.v1.StartTimerRequest")
+	proto.RegisterType((*StartTimerResponse)(nil), "todo.v1.StartTimerResponse")
+	proto.RegisterType((*StopTimerRequest)(nil), "todo.v1.StopTimerRequest")
+	proto.RegisterType((*StopTimerResponse)(nil), "todo.v1.StopTimerResponse")
+	proto.RegisterType((*ListTimeEntriesRequest)(nil), "todo.v1.ListTimeEntriesRequest")
+	proto.RegisterType((*ListTimeEntriesResponse)(nil), "todo.v1.ListTimeEntriesResponse")
+	proto.RegisterType((*TimeReportRequest)(nil), "todo.v1.TimeReportRequest")
+	proto.RegisterType((*TimeReportResponse)(nil), "todo.v1.TimeReportResponse")
+	proto.RegisterType((*TimeReportResponse_Row)(nil), "todo.v1.TimeReportResponse.Row")
+	proto.RegisterType((*CustomFieldSchema)(nil), "todo.v1.CustomFieldSchema")
+	proto.RegisterType((*RegisterCustomFieldSchemaRequest)(nil), "todo.v1.RegisterCustomFieldSchemaRequest")
+	proto.RegisterType((*RegisterCustomFieldSchemaResponse)(nil), "todo.v1.RegisterCustomFieldSchemaResponse")
+	proto.RegisterType((*GetCustomFieldSchemaRequest)(nil), "todo.v1.GetCustomFieldSchemaRequest")
+	proto.RegisterType((*GetCustomFieldSchemaResponse)(nil), "todo.v1.GetCustomFieldSchemaResponse")
+	proto.RegisterType((*CloneTodoRequest)(nil), "todo.v1.CloneTodoRequest")
+	proto.RegisterType((*CloneTodoResponse)(nil), "todo.v1.CloneTodoResponse")
+	proto.RegisterType((*TodoTemplate)(nil), "todo.v1.TodoTemplate")
+	proto.RegisterType((*TodoTemplate_Item)(nil), "todo.v1.TodoTemplate.Item")
+	proto.RegisterMapType((map[string]string)(nil), "todo.v1.TodoTemplate.Item.CustomFieldsEntry")
+	proto.RegisterType((*CreateTodoTemplateRequest)(nil), "todo.v1.CreateTodoTemplateRequest")
+	proto.RegisterType((*CreateTodoTemplateResponse)(nil), "todo.v1.CreateTodoTemplateResponse")
+	proto.RegisterType((*GetTodoTemplateRequest)(nil), "todo.v1.GetTodoTemplateRequest")
+	proto.RegisterType((*GetTodoTemplateResponse)(nil), "todo.v1.GetTodoTemplateResponse")
+	proto.RegisterType((*ListTodoTemplatesRequest)(nil), "todo.v1.ListTodoTemplatesRequest")
+	proto.RegisterType((*ListTodoTemplatesResponse)(nil), "todo.v1.ListTodoTemplatesResponse")
+	proto.RegisterType((*DeleteTodoTemplateRequest)(nil), "todo.v1.DeleteTodoTemplateRequest")
+	proto.RegisterType((*DeleteTodoTemplateResponse)(nil), "todo.v1.DeleteTodoTemplateResponse")
+	proto.RegisterType((*InstantiateTemplateRequest)(nil), "todo.v1.InstantiateTemplateRequest")
+	proto.RegisterType((*InstantiateTemplateResponse)(nil), "todo.v1.InstantiateTemplateResponse")
+	proto.RegisterType((*ImportTodosRequest)(nil), "todo.v1.ImportTodosRequest")
+	proto.RegisterType((*ImportTodosResponse)(nil), "todo.v1.ImportTodosResponse")
+	proto.RegisterType((*QuickAddTodoRequest)(nil), "todo.v1.QuickAddTodoRequest")
+	proto.RegisterType((*QuickAddTodoResponse)(nil), "todo.v1.QuickAddTodoResponse")
+	proto.RegisterType((*QuickAddTodoResponse_Token)(nil), "todo.v1.QuickAddTodoResponse.Token")
+	proto.RegisterType((*TodoEvent)(nil), "todo.v1.TodoEvent")
+	proto.RegisterType((*SnapshotHeader)(nil), "todo.v1.SnapshotHeader")
+	proto.RegisterType((*SnapshotRecord)(nil), "todo.v1.SnapshotRecord")
+	proto.RegisterEnum("todo.v1.UpdateTodosResponse_Result_Status", UpdateTodosResponse_Result_Status_name, UpdateTodosResponse_Result_Status_value)
+	proto.RegisterEnum("todo.v1.TimeReportRequest_GroupBy", TimeReportRequest_GroupBy_name, TimeReportRequest_GroupBy_value)
+	proto.RegisterEnum("todo.v1.TodoEvent_Type", TodoEvent_Type_name, TodoEvent_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TodoServiceClient is the client API for TodoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TodoServiceClient interface {
+	CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error)
+	// Bulk version of CreateTodo
+	CreateTodos(ctx context.Context, in *CreateTodosRequest, opts ...grpc.CallOption) (*CreateTodosResponse, error)
+	GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*GetTodoResponse, error)
+	ListTodo(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error)
+	// Bulk version of DeleteTodo, declared first so that /v1/todo/bulk
+	// is not routed to DeleteTodo by the gateway
+	DeleteTodos(ctx context.Context, in *DeleteTodosRequest, opts ...grpc.CallOption) (*DeleteTodosResponse, error)
+	DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error)
+	// Deletes every todo matching a filter
+	DeleteTodosByFilter(ctx context.Context, in *DeleteTodosByFilterRequest, opts ...grpc.CallOption) (*DeleteTodosByFilterResponse, error)
+	UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*UpdateTodoResponse, error)
+	UpdateTodos(ctx context.Context, in *UpdateTodosRequest, opts ...grpc.CallOption) (*UpdateTodosResponse, error)
+	// Starts a timer on a todo, a user can only have one running timer
+	StartTimer(ctx context.Context, in *StartTimerRequest, opts ...grpc.CallOption) (*StartTimerResponse, error)
+	// Stops the running timer of a user
+	StopTimer(ctx context.Context, in *StopTimerRequest, opts ...grpc.CallOption) (*StopTimerResponse, error)
+	ListTimeEntries(ctx context.Context, in *ListTimeEntriesRequest, opts ...grpc.CallOption) (*ListTimeEntriesResponse, error)
+	// Sums the tracked time per todo, tag or list over a date range
+	TimeReport(ctx context.Context, in *TimeReportRequest, opts ...grpc.CallOption) (*TimeReportResponse, error)
+	// Registers the JSON Schema validating the custom fields of the items of a list
+	RegisterCustomFieldSchema(ctx context.Context, in *RegisterCustomFieldSchemaRequest, opts ...grpc.CallOption) (*RegisterCustomFieldSchemaResponse, error)
+	GetCustomFieldSchema(ctx context.Context, in *GetCustomFieldSchemaRequest, opts ...grpc.CallOption) (*GetCustomFieldSchemaResponse, error)
+	// Deep-copies a todo and its subtasks under new IDs
+	CloneTodo(ctx context.Context, in *CloneTodoRequest, opts ...grpc.CallOption) (*CloneTodoResponse, error)
+	CreateTodoTemplate(ctx context.Context, in *CreateTodoTemplateRequest, opts ...grpc.CallOption) (*CreateTodoTemplateResponse, error)
+	GetTodoTemplate(ctx context.Context, in *GetTodoTemplateRequest, opts ...grpc.CallOption) (*GetTodoTemplateResponse, error)
+	ListTodoTemplates(ctx context.Context, in *ListTodoTemplatesRequest, opts ...grpc.CallOption) (*ListTodoTemplatesResponse, error)
+	DeleteTodoTemplate(ctx context.Context, in *DeleteTodoTemplateRequest, opts ...grpc.CallOption) (*DeleteTodoTemplateResponse, error)
+	// Creates the todos described by a template in a single transaction
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error)
+	// Creates a todo from a sentence such as "pay rent tomorrow 9am #finance !high every month"
+	QuickAddTodo(ctx context.Context, in *QuickAddTodoRequest, opts ...grpc.CallOption) (*QuickAddTodoResponse, error)
+	// Creates or updates todos from the VTODO components of an iCalendar
+	// document, matching existing todos by UID
+	ImportTodos(ctx context.Context, in *ImportTodosRequest, opts ...grpc.CallOption) (*ImportTodosResponse, error)
+}
+
+type todoServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTodoServiceClient(cc *grpc.ClientConn) TodoServiceClient {
+	return &todoServiceClient{cc}
+}
+
+func (c *todoServiceClient) CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error) {
+	out := new(CreateTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CreateTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateTodos(ctx context.Context, in *CreateTodosRequest, opts ...grpc.CallOption) (*CreateTodosResponse, error) {
+	out := new(CreateTodosResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CreateTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*GetTodoResponse, error) {
+	out := new(GetTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/GetTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTodo(ctx context.Context, in *ListTodoRequest, opts ...grpc.CallOption) (*ListTodoResponse, error) {
+	out := new(ListTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/ListTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTodos(ctx context.Context, in *DeleteTodosRequest, opts ...grpc.CallOption) (*DeleteTodosResponse, error) {
+	out := new(DeleteTodosResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/DeleteTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error) {
+	out := new(DeleteTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/DeleteTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTodosByFilter(ctx context.Context, in *DeleteTodosByFilterRequest, opts ...grpc.CallOption) (*DeleteTodosByFilterResponse, error) {
+	out := new(DeleteTodosByFilterResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/DeleteTodosByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*UpdateTodoResponse, error) {
+	out := new(UpdateTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/UpdateTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateTodos(ctx context.Context, in *UpdateTodosRequest, opts ...grpc.CallOption) (*UpdateTodosResponse, error) {
+	out := new(UpdateTodosResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/UpdateTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) StartTimer(ctx context.Context, in *StartTimerRequest, opts ...grpc.CallOption) (*StartTimerResponse, error) {
+	out := new(StartTimerResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/StartTimer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) StopTimer(ctx context.Context, in *StopTimerRequest, opts ...grpc.CallOption) (*StopTimerResponse, error) {
+	out := new(StopTimerResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/StopTimer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTimeEntries(ctx context.Context, in *ListTimeEntriesRequest, opts ...grpc.CallOption) (*ListTimeEntriesResponse, error) {
+	out := new(ListTimeEntriesResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/ListTimeEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) TimeReport(ctx context.Context, in *TimeReportRequest, opts ...grpc.CallOption) (*TimeReportResponse, error) {
+	out := new(TimeReportResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/TimeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RegisterCustomFieldSchema(ctx context.Context, in *RegisterCustomFieldSchemaRequest, opts ...grpc.CallOption) (*RegisterCustomFieldSchemaResponse, error) {
+	out := new(RegisterCustomFieldSchemaResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/RegisterCustomFieldSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetCustomFieldSchema(ctx context.Context, in *GetCustomFieldSchemaRequest, opts ...grpc.CallOption) (*GetCustomFieldSchemaResponse, error) {
+	out := new(GetCustomFieldSchemaResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/GetCustomFieldSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CloneTodo(ctx context.Context, in *CloneTodoRequest, opts ...grpc.CallOption) (*CloneTodoResponse, error) {
+	out := new(CloneTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CloneTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateTodoTemplate(ctx context.Context, in *CreateTodoTemplateRequest, opts ...grpc.CallOption) (*CreateTodoTemplateResponse, error) {
+	out := new(CreateTodoTemplateResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/CreateTodoTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTodoTemplate(ctx context.Context, in *GetTodoTemplateRequest, opts ...grpc.CallOption) (*GetTodoTemplateResponse, error) {
+	out := new(GetTodoTemplateResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/GetTodoTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTodoTemplates(ctx context.Context, in *ListTodoTemplatesRequest, opts ...grpc.CallOption) (*ListTodoTemplatesResponse, error) {
+	out := new(ListTodoTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/ListTodoTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTodoTemplate(ctx context.Context, in *DeleteTodoTemplateRequest, opts ...grpc.CallOption) (*DeleteTodoTemplateResponse, error) {
+	out := new(DeleteTodoTemplateResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/DeleteTodoTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error) {
+	out := new(InstantiateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/InstantiateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) QuickAddTodo(ctx context.Context, in *QuickAddTodoRequest, opts ...grpc.CallOption) (*QuickAddTodoResponse, error) {
+	out := new(QuickAddTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/QuickAddTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ImportTodos(ctx context.Context, in *ImportTodosRequest, opts ...grpc.CallOption) (*ImportTodosResponse, error) {
+	out := new(ImportTodosResponse)
+	err := c.cc.Invoke(ctx, "/todo.v1.TodoService/ImportTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoServiceServer is the server API for TodoService service.
+type TodoServiceServer interface {
+	CreateTodo(context.Context, *CreateTodoRequest) (*CreateTodoResponse, error)
+	// Bulk version of CreateTodo
+	CreateTodos(context.Context, *CreateTodosRequest) (*CreateTodosResponse, error)
+	GetTodo(context.Context, *GetTodoRequest) (*GetTodoResponse, error)
+	ListTodo(context.Context, *ListTodoRequest) (*ListTodoResponse, error)
+	// Bulk version of DeleteTodo, declared first so that /v1/todo/bulk
+	// is not routed to DeleteTodo by the gateway
+	DeleteTodos(context.Context, *DeleteTodosRequest) (*DeleteTodosResponse, error)
+	DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error)
+	// Deletes every todo matching a filter
+	DeleteTodosByFilter(context.Context, *DeleteTodosByFilterRequest) (*DeleteTodosByFilterResponse, error)
+	UpdateTodo(context.Context, *UpdateTodoRequest) (*UpdateTodoResponse, error)
+	UpdateTodos(context.Context, *UpdateTodosRequest) (*UpdateTodosResponse, error)
+	// Starts a timer on a todo, a user can only have one running timer
+	StartTimer(context.Context, *StartTimerRequest) (*StartTimerResponse, error)
+	// Stops the running timer of a user
+	StopTimer(context.Context, *StopTimerRequest) (*StopTimerResponse, error)
+	ListTimeEntries(context.Context, *ListTimeEntriesRequest) (*ListTimeEntriesResponse, error)
+	// Sums the tracked time per todo, tag or list over a date range
+	TimeReport(context.Context, *TimeReportRequest) (*TimeReportResponse, error)
+	// Registers the JSON Schema validating the custom fields of the items of a list
+	RegisterCustomFieldSchema(context.Context, *RegisterCustomFieldSchemaRequest) (*RegisterCustomFieldSchemaResponse, error)
+	GetCustomFieldSchema(context.Context, *GetCustomFieldSchemaRequest) (*GetCustomFieldSchemaResponse, error)
+	// Deep-copies a todo and its subtasks under new IDs
+	CloneTodo(context.Context, *CloneTodoRequest) (*CloneTodoResponse, error)
+	CreateTodoTemplate(context.Context, *CreateTodoTemplateRequest) (*CreateTodoTemplateResponse, error)
+	GetTodoTemplate(context.Context, *GetTodoTemplateRequest) (*GetTodoTemplateResponse, error)
+	ListTodoTemplates(context.Context, *ListTodoTemplatesRequest) (*ListTodoTemplatesResponse, error)
+	DeleteTodoTemplate(context.Context, *DeleteTodoTemplateRequest) (*DeleteTodoTemplateResponse, error)
+	// Creates the todos described by a template in a single transaction
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error)
+	// Creates a todo from a sentence such as "pay rent tomorrow 9am #finance !high every month"
+	QuickAddTodo(context.Context, *QuickAddTodoRequest) (*QuickAddTodoResponse, error)
+	// Creates or updates todos from the VTODO components of an iCalendar
+	// document, matching existing todos by UID
+	ImportTodos(context.Context, *ImportTodosRequest) (*ImportTodosResponse, error)
+}
+
+func RegisterTodoServiceServer(s *grpc.Server, srv TodoServiceServer) {
+	s.RegisterService(&_TodoService_serviceDesc, srv)
+}
+
+func _TodoService_CreateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/CreateTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodo(ctx, req.(*CreateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/CreateTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodos(ctx, req.(*CreateTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/GetTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodo(ctx, req.(*GetTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/ListTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTodo(ctx, req.(*ListTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/DeleteTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodos(ctx, req.(*DeleteTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/DeleteTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, req.(*DeleteTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodosByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodosByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodosByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/DeleteTodosByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodosByFilter(ctx, req.(*DeleteTodosByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/UpdateTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTodo(ctx, req.(*UpdateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/UpdateTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTodos(ctx, req.(*UpdateTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_StartTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).StartTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/StartTimer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).StartTimer(ctx, req.(*StartTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_StopTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).StopTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/StopTimer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).StopTimer(ctx, req.(*StopTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTimeEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimeEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTimeEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/ListTimeEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTimeEntries(ctx, req.(*ListTimeEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_TimeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).TimeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/TimeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).TimeReport(ctx, req.(*TimeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RegisterCustomFieldSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCustomFieldSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RegisterCustomFieldSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/RegisterCustomFieldSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RegisterCustomFieldSchema(ctx, req.(*RegisterCustomFieldSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetCustomFieldSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomFieldSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetCustomFieldSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/GetCustomFieldSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetCustomFieldSchema(ctx, req.(*GetCustomFieldSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CloneTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CloneTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/CloneTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CloneTodo(ctx, req.(*CloneTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateTodoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/CreateTodoTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodoTemplate(ctx, req.(*CreateTodoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/GetTodoTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodoTemplate(ctx, req.(*GetTodoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodoTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTodoTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/ListTodoTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTodoTemplates(ctx, req.(*ListTodoTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/DeleteTodoTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodoTemplate(ctx, req.(*DeleteTodoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/InstantiateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_QuickAddTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickAddTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).QuickAddTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/QuickAddTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).QuickAddTodo(ctx, req.(*QuickAddTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ImportTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ImportTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.v1.TodoService/ImportTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ImportTodos(ctx, req.(*ImportTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TodoService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.v1.TodoService",
+	HandlerType: (*TodoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTodo",
+			Handler:    _TodoService_CreateTodo_Handler,
+		},
+		{
+			MethodName: "CreateTodos",
+			Handler:    _TodoService_CreateTodos_Handler,
+		},
+		{
+			MethodName: "GetTodo",
+			Handler:    _TodoService_GetTodo_Handler,
+		},
+		{
+			MethodName: "ListTodo",
+			Handler:    _TodoService_ListTodo_Handler,
+		},
+		{
+			MethodName: "DeleteTodos",
+			Handler:    _TodoService_DeleteTodos_Handler,
+		},
+		{
+			MethodName: "DeleteTodo",
+			Handler:    _TodoService_DeleteTodo_Handler,
+		},
+		{
+			MethodName: "DeleteTodosByFilter",
+			Handler:    _TodoService_DeleteTodosByFilter_Handler,
+		},
+		{
+			MethodName: "UpdateTodo",
+			Handler:    _TodoService_UpdateTodo_Handler,
+		},
+		{
+			MethodName: "UpdateTodos",
+			Handler:    _TodoService_UpdateTodos_Handler,
+		},
+		{
+			MethodName: "StartTimer",
+			Handler:    _TodoService_StartTimer_Handler,
+		},
+		{
+			MethodName: "StopTimer",
+			Handler:    _TodoService_StopTimer_Handler,
+		},
+		{
+			MethodName: "ListTimeEntries",
+			Handler:    _TodoService_ListTimeEntries_Handler,
+		},
+		{
+			MethodName: "TimeReport",
+			Handler:    _TodoService_TimeReport_Handler,
+		},
+		{
+			MethodName: "RegisterCustomFieldSchema",
+			Handler:    _TodoService_RegisterCustomFieldSchema_Handler,
+		},
+		{
+			MethodName: "GetCustomFieldSchema",
+			Handler:    _TodoService_GetCustomFieldSchema_Handler,
+		},
+		{
+			MethodName: "CloneTodo",
+			Handler:    _TodoService_CloneTodo_Handler,
+		},
+		{
+			MethodName: "CreateTodoTemplate",
+			Handler:    _TodoService_CreateTodoTemplate_Handler,
+		},
+		{
+			MethodName: "GetTodoTemplate",
+			Handler:    _TodoService_GetTodoTemplate_Handler,
+		},
+		{
+			MethodName: "ListTodoTemplates",
+			Handler:    _TodoService_ListTodoTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTodoTemplate",
+			Handler:    _TodoService_DeleteTodoTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _TodoService_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "QuickAddTodo",
+			Handler:    _TodoService_QuickAddTodo_Handler,
+		},
+		{
+			MethodName: "ImportTodos",
+			Handler:    _TodoService_ImportTodos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/gofunct/gotasks/api/todo/v1/todo.proto",
+}
+
+func (m *Todo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Todo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.Completed {
+		dAtA[i] = 0x20
+		i++
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.CreatedAt.Size()))
+		n1, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.UpdatedAt.Size()))
+		n2, err := m.UpdatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if m.TrackedSeconds != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.TrackedSeconds))
+	}
+	if len(m.CustomFields) > 0 {
+		for k, _ := range m.CustomFields {
+			dAtA[i] = 0x5a
+			i++
+			v := m.CustomFields[k]
+			mapSize := 1 + len(k) + sovTodo(uint64(len(k))) + 1 + len(v) + sovTodo(uint64(len(v)))
+			i = encodeVarintTodo(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.ParentId) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.ParentId)))
+		i += copy(dAtA[i:], m.ParentId)
+	}
+	if m.DueAt != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.DueAt.Size()))
+		n3, err := m.DueAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.DescriptionHtml) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.DescriptionHtml)))
+		i += copy(dAtA[i:], m.DescriptionHtml)
+	}
+	if m.TaskProgress != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.TaskProgress.Size()))
+		n4, err := m.TaskProgress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Recurrence) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Recurrence)))
+		i += copy(dAtA[i:], m.Recurrence)
+	}
+	if len(m.IcalUid) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.IcalUid)))
+		i += copy(dAtA[i:], m.IcalUid)
+	}
+	return i, nil
+}
+
+func (m *TaskProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Done != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Done))
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *TimeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.TodoId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.TodoId)))
+		i += copy(dAtA[i:], m.TodoId)
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if m.StartedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.StartedAt.Size()))
+		n5, err := m.StartedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.StoppedAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.StoppedAt.Size()))
+		n6, err := m.StoppedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.DurationSeconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.DurationSeconds))
+	}
+	return i, nil
+}
+
+func (m *CreateTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n7, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *CreateTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *CreateTodosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateTodosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Render) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Render)))
+		i += copy(dAtA[i:], m.Render)
+	}
+	if len(m.Consistency) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Consistency)))
+		i += copy(dAtA[i:], m.Consistency)
+	}
+	return i, nil
+}
+
+func (m *GetTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n8, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *ListTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Limit))
+	}
+	if m.NotCompleted {
+		dAtA[i] = 0x10
+		i++
+		if m.NotCompleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.CustomFieldFilters) > 0 {
+		for _, s := range m.CustomFieldFilters {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.OrderBy) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.OrderBy)))
+		i += copy(dAtA[i:], m.OrderBy)
+	}
+	if len(m.Render) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Render)))
+		i += copy(dAtA[i:], m.Render)
+	}
+	if len(m.Consistency) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Consistency)))
+		i += copy(dAtA[i:], m.Consistency)
+	}
+	if len(m.AfterId) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.AfterId)))
+		i += copy(dAtA[i:], m.AfterId)
+	}
+	return i, nil
+}
+
+func (m *ListTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *DeleteTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *DeleteTodosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteTodosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Deleted))
+	}
+	return i, nil
+}
+
+func (m *DeleteTodosByFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodosByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OnlyCompleted {
+		dAtA[i] = 0x8
+		i++
+		if m.OnlyCompleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.CustomFieldFilters) > 0 {
+		for _, s := range m.CustomFieldFilters {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Confirm {
+		dAtA[i] = 0x28
+		i++
+		if m.Confirm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DryRun {
+		dAtA[i] = 0x30
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeleteTodosByFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodosByFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *UpdateTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n9, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *UpdateTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *UpdateTodosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTodosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Strict {
+		dAtA[i] = 0x10
+		i++
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateTodosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTodosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *UpdateTodosResponse_Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTodosResponse_Result) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *StartTimerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTimerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TodoId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.TodoId)))
+		i += copy(dAtA[i:], m.TodoId)
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	return i, nil
+}
+
+func (m *StartTimerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTimerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Entry.Size()))
+		n10, err := m.Entry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *StopTimerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopTimerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	return i, nil
+}
+
+func (m *StopTimerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopTimerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Entry.Size()))
+		n11, err := m.Entry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *ListTimeEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTimeEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TodoId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.TodoId)))
+		i += copy(dAtA[i:], m.TodoId)
+	}
+	return i, nil
+}
+
+func (m *ListTimeEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTimeEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TimeReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.From != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.From.Size()))
+		n12, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.To != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.To.Size()))
+		n13, err := m.To.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.GroupBy != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.GroupBy))
+	}
+	return i, nil
+}
+
+func (m *TimeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, msg := range m.Rows {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TimeReportResponse_Row) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeReportResponse_Row) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Seconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Seconds))
+	}
+	return i, nil
+}
+
+func (m *CustomFieldSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomFieldSchema) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.Schema) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Schema)))
+		i += copy(dAtA[i:], m.Schema)
+	}
+	if m.UpdatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.UpdatedAt.Size()))
+		n14, err := m.UpdatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *RegisterCustomFieldSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCustomFieldSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if len(m.Schema) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Schema)))
+		i += copy(dAtA[i:], m.Schema)
+	}
+	return i, nil
+}
+
+func (m *RegisterCustomFieldSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCustomFieldSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetCustomFieldSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCustomFieldSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	return i, nil
+}
+
+func (m *GetCustomFieldSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCustomFieldSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Schema.Size()))
+		n15, err := m.Schema.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *CloneTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloneTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *CloneTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloneTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *TodoTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TodoTemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.List) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.List)))
+		i += copy(dAtA[i:], m.List)
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n16, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.CreatedAt.Size()))
+		n17, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *TodoTemplate_Item) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TodoTemplate_Item) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Title) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.CustomFields) > 0 {
+		for k, _ := range m.CustomFields {
+			dAtA[i] = 0x22
+			i++
+			v := m.CustomFields[k]
+			mapSize := 1 + len(k) + sovTodo(uint64(len(k))) + 1 + len(v) + sovTodo(uint64(len(v)))
+			i = encodeVarintTodo(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.DueOffsetSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.DueOffsetSeconds))
+	}
+	if len(m.Subtasks) > 0 {
+		for _, msg := range m.Subtasks {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateTodoTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodoTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Template != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Template.Size()))
+		n18, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+
+func (m *CreateTodoTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTodoTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *GetTodoTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTodoTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *GetTodoTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTodoTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Template != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Template.Size()))
+		n19, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+
+func (m *ListTodoTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTodoTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListTodoTemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTodoTemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, msg := range m.Templates {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DeleteTodoTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodoTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *DeleteTodoTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTodoTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *InstantiateTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantiateTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Base != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Base.Size()))
+		n20, err := m.Base.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *InstantiateTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantiateTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ImportTodosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportTodosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calendar) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Calendar)))
+		i += copy(dAtA[i:], m.Calendar)
+	}
+	return i, nil
+}
+
+func (m *ImportTodosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportTodosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *QuickAddTodoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuickAddTodoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Text) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *QuickAddTodoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuickAddTodoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n21, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTodo(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QuickAddTodoResponse_Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuickAddTodoResponse_Token) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Text) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	return i, nil
+}
+
+func (m *TodoEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TodoEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Id))
+	}
+	if len(m.TodoId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.TodoId)))
+		i += copy(dAtA[i:], m.TodoId)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Type))
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Item.Size()))
+		n22, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.CreatedAt.Size()))
+		n23, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *SnapshotHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FormatVersion != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.FormatVersion))
+	}
+	if m.SchemaVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.SchemaVersion))
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.CreatedAt.Size()))
+		n24, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.Checksum) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Checksum)))
+		i += copy(dAtA[i:], m.Checksum)
+	}
+	if m.Records != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Records))
+	}
+	if len(m.Tenant) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Tenant)))
+		i += copy(dAtA[i:], m.Tenant)
+	}
+	if len(m.Driver) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(len(m.Driver)))
+		i += copy(dAtA[i:], m.Driver)
+	}
+	return i, nil
+}
+
+func (m *SnapshotRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		nn25, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn25
+	}
+	return i, nil
+}
+
+func (m *SnapshotRecord_Todo) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Todo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Todo.Size()))
+		n26, err := m.Todo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+func (m *SnapshotRecord_TimeEntry) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.TimeEntry != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.TimeEntry.Size()))
+		n27, err := m.TimeEntry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+func (m *SnapshotRecord_CustomFieldSchema) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CustomFieldSchema != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.CustomFieldSchema.Size()))
+		n28, err := m.CustomFieldSchema.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *SnapshotRecord_Template) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Template != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTodo(dAtA, i, uint64(m.Template.Size()))
+		n29, err := m.Template.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+func encodeVarintTodo(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Todo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.TrackedSeconds != 0 {
+		n += 1 + sovTodo(uint64(m.TrackedSeconds))
+	}
+	if len(m.CustomFields) > 0 {
+		for k, v := range m.CustomFields {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTodo(uint64(len(k))) + 1 + len(v) + sovTodo(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTodo(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.DueAt != nil {
+		l = m.DueAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.DescriptionHtml)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.TaskProgress != nil {
+		l = m.TaskProgress.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Recurrence)
+	if l > 0 {
+		n += 2 + l + sovTodo(uint64(l))
+	}
+	l = len(m.IcalUid)
+	if l > 0 {
+		n += 2 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Done != 0 {
+		n += 1 + sovTodo(uint64(m.Done))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTodo(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *TimeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.TodoId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.StoppedAt != nil {
+		l = m.StoppedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovTodo(uint64(m.DurationSeconds))
+	}
+	return n
+}
+
+func (m *CreateTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTodosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateTodosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Render)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Consistency)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovTodo(uint64(m.Limit))
+	}
+	if m.NotCompleted {
+		n += 2
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if len(m.CustomFieldFilters) > 0 {
+		for _, s := range m.CustomFieldFilters {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Render)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Consistency)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.AfterId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteTodosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteTodosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deleted != 0 {
+		n += 1 + sovTodo(uint64(m.Deleted))
+	}
+	return n
+}
+
+func (m *DeleteTodosByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OnlyCompleted {
+		n += 2
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if len(m.CustomFieldFilters) > 0 {
+		for _, s := range m.CustomFieldFilters {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	if m.Confirm {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteTodosByFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovTodo(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *UpdateTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateTodosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	if m.Strict {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateTodosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateTodosResponse_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTodo(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *StartTimerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TodoId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *StartTimerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *StopTimerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *StopTimerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTimeEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TodoId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTimeEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.GroupBy != 0 {
+		n += 1 + sovTodo(uint64(m.GroupBy))
+	}
+	return n
+}
+
+func (m *TimeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeReportResponse_Row) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovTodo(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *CustomFieldSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterCustomFieldSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterCustomFieldSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCustomFieldSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCustomFieldSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *CloneTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *CloneTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *TodoTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *TodoTemplate_Item) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	if len(m.CustomFields) > 0 {
+		for k, v := range m.CustomFields {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTodo(uint64(len(k))) + 1 + len(v) + sovTodo(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTodo(uint64(mapEntrySize))
+		}
+	}
+	if m.DueOffsetSeconds != 0 {
+		n += 1 + sovTodo(uint64(m.DueOffsetSeconds))
+	}
+	if len(m.Subtasks) > 0 {
+		for _, e := range m.Subtasks {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateTodoTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTodoTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTodoTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTodoTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTodoTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTodoTemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteTodoTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteTodoTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InstantiateTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *InstantiateTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImportTodosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Calendar)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportTodosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	if m.Created != 0 {
+		n += 1 + sovTodo(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovTodo(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *QuickAddTodoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuickAddTodoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTodo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuickAddTodoResponse_Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *TodoEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTodo(uint64(m.Id))
+	}
+	l = len(m.TodoId)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTodo(uint64(m.Type))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FormatVersion != 0 {
+		n += 1 + sovTodo(uint64(m.FormatVersion))
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovTodo(uint64(m.SchemaVersion))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	if m.Records != 0 {
+		n += 1 + sovTodo(uint64(m.Records))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	l = len(m.Driver)
+	if l > 0 {
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		n += m.Record.Size()
+	}
+	return n
+}
+
+func (m *SnapshotRecord_Todo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Todo != nil {
+		l = m.Todo.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotRecord_TimeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeEntry != nil {
+		l = m.TimeEntry.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotRecord_CustomFieldSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CustomFieldSchema != nil {
+		l = m.CustomFieldSchema.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotRecord_Template) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovTodo(uint64(l))
+	}
+	return n
+}
+
+func sovTodo(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTodo(x uint64) (n int) {
+	return sovTodo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Todo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomFields := make([]string, 0, len(this.CustomFields))
+	for k, _ := range this.CustomFields {
+		keysForCustomFields = append(keysForCustomFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomFields)
+	mapStringForCustomFields := "map[string]string{"
+	for _, k := range keysForCustomFields {
+		mapStringForCustomFields += fmt.Sprintf("%v: %v,", k, this.CustomFields[k])
+	}
+	mapStringForCustomFields += "}"
+	s := strings.Join([]string{`&Todo{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`TrackedSeconds:` + fmt.Sprintf("%v", this.TrackedSeconds) + `,`,
+		`CustomFields:` + mapStringForCustomFields + `,`,
+		`ParentId:` + fmt.Sprintf("%v", this.ParentId) + `,`,
+		`DueAt:` + strings.Replace(fmt.Sprintf("%v", this.DueAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DescriptionHtml:` + fmt.Sprintf("%v", this.DescriptionHtml) + `,`,
+		`TaskProgress:` + strings.Replace(fmt.Sprintf("%v", this.TaskProgress), "TaskProgress", "TaskProgress", 1) + `,`,
+		`Recurrence:` + fmt.Sprintf("%v", this.Recurrence) + `,`,
+		`IcalUid:` + fmt.Sprintf("%v", this.IcalUid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskProgress{`,
+		`Done:` + fmt.Sprintf("%v", this.Done) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeEntry{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`TodoId:` + fmt.Sprintf("%v", this.TodoId) + `,`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StoppedAt:` + strings.Replace(fmt.Sprintf("%v", this.StoppedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DurationSeconds:` + fmt.Sprintf("%v", this.DurationSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodoRequest{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodoResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodosRequest{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodosResponse{`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTodoRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Render:` + fmt.Sprintf("%v", this.Render) + `,`,
+		`Consistency:` + fmt.Sprintf("%v", this.Consistency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTodoResponse{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTodoRequest{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`NotCompleted:` + fmt.Sprintf("%v", this.NotCompleted) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`CustomFieldFilters:` + fmt.Sprintf("%v", this.CustomFieldFilters) + `,`,
+		`OrderBy:` + fmt.Sprintf("%v", this.OrderBy) + `,`,
+		`Render:` + fmt.Sprintf("%v", this.Render) + `,`,
+		`Consistency:` + fmt.Sprintf("%v", this.Consistency) + `,`,
+		`AfterId:` + fmt.Sprintf("%v", this.AfterId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTodoResponse{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodoRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodoResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodosRequest{`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodosResponse{`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodosByFilterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodosByFilterRequest{`,
+		`OnlyCompleted:` + fmt.Sprintf("%v", this.OnlyCompleted) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`CustomFieldFilters:` + fmt.Sprintf("%v", this.CustomFieldFilters) + `,`,
+		`Confirm:` + fmt.Sprintf("%v", this.Confirm) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodosByFilterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodosByFilterResponse{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTodoRequest{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTodoResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTodosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTodosRequest{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Todo", "Todo", 1) + `,`,
+		`Strict:` + fmt.Sprintf("%v", this.Strict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTodosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTodosResponse{`,
+		`Results:` + strings.Replace(fmt.Sprintf("%v", this.Results), "UpdateTodosResponse_Result", "UpdateTodosResponse_Result", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTodosResponse_Result) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTodosResponse_Result{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartTimerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartTimerRequest{`,
+		`TodoId:` + fmt.Sprintf("%v", this.TodoId) + `,`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartTimerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartTimerResponse{`,
+		`Entry:` + strings.Replace(fmt.Sprintf("%v", this.Entry), "TimeEntry", "TimeEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopTimerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopTimerRequest{`,
+		`UserId:` + fmt.Sprintf("%v", this.UserId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopTimerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopTimerResponse{`,
+		`Entry:` + strings.Replace(fmt.Sprintf("%v", this.Entry), "TimeEntry", "TimeEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTimeEntriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTimeEntriesRequest{`,
+		`TodoId:` + fmt.Sprintf("%v", this.TodoId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTimeEntriesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTimeEntriesResponse{`,
+		`Entries:` + strings.Replace(fmt.Sprintf("%v", this.Entries), "TimeEntry", "TimeEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeReportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeReportRequest{`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeReportResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeReportResponse{`,
+		`Rows:` + strings.Replace(fmt.Sprintf("%v", this.Rows), "TimeReportResponse_Row", "TimeReportResponse_Row", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeReportResponse_Row) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeReportResponse_Row{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Seconds:` + fmt.Sprintf("%v", this.Seconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomFieldSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomFieldSchema{`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterCustomFieldSchemaRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterCustomFieldSchemaRequest{`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterCustomFieldSchemaResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterCustomFieldSchemaResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetCustomFieldSchemaRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetCustomFieldSchemaRequest{`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetCustomFieldSchemaResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetCustomFieldSchemaResponse{`,
+		`Schema:` + strings.Replace(fmt.Sprintf("%v", this.Schema), "CustomFieldSchema", "CustomFieldSchema", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloneTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloneTodoRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloneTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloneTodoResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TodoTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TodoTemplate{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "TodoTemplate_Item", "TodoTemplate_Item", 1) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TodoTemplate_Item) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForCustomFields := make([]string, 0, len(this.CustomFields))
+	for k, _ := range this.CustomFields {
+		keysForCustomFields = append(keysForCustomFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomFields)
+	mapStringForCustomFields := "map[string]string{"
+	for _, k := range keysForCustomFields {
+		mapStringForCustomFields += fmt.Sprintf("%v: %v,", k, this.CustomFields[k])
+	}
+	mapStringForCustomFields += "}"
+	s := strings.Join([]string{`&TodoTemplate_Item{`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`CustomFields:` + mapStringForCustomFields + `,`,
+		`DueOffsetSeconds:` + fmt.Sprintf("%v", this.DueOffsetSeconds) + `,`,
+		`Subtasks:` + strings.Replace(fmt.Sprintf("%v", this.Subtasks), "TodoTemplate_Item", "TodoTemplate_Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodoTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodoTemplateRequest{`,
+		`Template:` + strings.Replace(fmt.Sprintf("%v", this.Template), "TodoTemplate", "TodoTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTodoTemplateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTodoTemplateResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTodoTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTodoTemplateRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTodoTemplateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTodoTemplateResponse{`,
+		`Template:` + strings.Replace(fmt.Sprintf("%v", this.Template), "TodoTemplate", "TodoTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTodoTemplatesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTodoTemplatesRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTodoTemplatesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTodoTemplatesResponse{`,
+		`Templates:` + strings.Replace(fmt.Sprintf("%v", this.Templates), "TodoTemplate", "TodoTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodoTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodoTemplateRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTodoTemplateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTodoTemplateResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InstantiateTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InstantiateTemplateRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Base:` + strings.Replace(fmt.Sprintf("%v", this.Base), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InstantiateTemplateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InstantiateTemplateResponse{`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportTodosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportTodosRequest{`,
+		`Calendar:` + fmt.Sprintf("%v", this.Calendar) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportTodosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportTodosResponse{`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuickAddTodoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuickAddTodoRequest{`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuickAddTodoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuickAddTodoResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "QuickAddTodoResponse_Token", "QuickAddTodoResponse_Token", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuickAddTodoResponse_Token) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuickAddTodoResponse_Token{`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TodoEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TodoEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`TodoId:` + fmt.Sprintf("%v", this.TodoId) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Todo", "Todo", 1) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotHeader{`,
+		`FormatVersion:` + fmt.Sprintf("%v", this.FormatVersion) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`Records:` + fmt.Sprintf("%v", this.Records) + `,`,
+		`Tenant:` + fmt.Sprintf("%v", this.Tenant) + `,`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRecord{`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRecord_Todo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRecord_Todo{`,
+		`Todo:` + strings.Replace(fmt.Sprintf("%v", this.Todo), "Todo", "Todo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRecord_TimeEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRecord_TimeEntry{`,
+		`TimeEntry:` + strings.Replace(fmt.Sprintf("%v", this.TimeEntry), "TimeEntry", "TimeEntry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRecord_CustomFieldSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRecord_CustomFieldSchema{`,
+		`CustomFieldSchema:` + strings.Replace(fmt.Sprintf("%v", this.CustomFieldSchema), "CustomFieldSchema", "CustomFieldSchema", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRecord_Template) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRecord_Template{`,
+		`Template:` + strings.Replace(fmt.Sprintf("%v", this.Template), "TodoTemplate", "TodoTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTodo(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Todo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Todo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Todo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedSeconds", wireType)
+			}
+			m.TrackedSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackedSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomFields == nil {
+				m.CustomFields = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTodo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTodo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTodo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTodo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTodo
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTodo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTodo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomFields[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DueAt == nil {
+				m.DueAt = &types.Timestamp{}
+			}
+			if err := m.DueAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHtml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHtml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskProgress == nil {
+				m.TaskProgress = &TaskProgress{}
+			}
+			if err := m.TaskProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcalUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcalUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			m.Done = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Done |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TodoId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TodoId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &types.Timestamp{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoppedAt == nil {
+				m.StoppedAt = &types.Timestamp{}
+			}
+			if err := m.StoppedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Todo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Todo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Render = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consistency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consistency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Todo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotCompleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotCompleted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomFieldFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomFieldFilters = append(m.CustomFieldFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Render = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consistency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consistency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Todo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodosByFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodosByFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodosByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyCompleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyCompleted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomFieldFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomFieldFilters = append(m.CustomFieldFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirm = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodosByFilterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodosByFilterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodosByFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Todo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTodosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTodosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Todo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTodosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTodosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &UpdateTodosResponse_Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTodosResponse_Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (UpdateTodosResponse_Result_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTimerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTimerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTimerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TodoId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TodoId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTimerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTimerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTimerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &TimeEntry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopTimerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopTimerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopTimerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopTimerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopTimerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopTimerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &TimeEntry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTimeEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTimeEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTimeEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TodoId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TodoId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTimeEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTimeEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTimeEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &TimeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &types.Timestamp{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &types.Timestamp{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			m.GroupBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupBy |= (TimeReportRequest_GroupBy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &TimeReportResponse_Row{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeReportResponse_Row) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Row: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Row: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomFieldSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomFieldSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomFieldSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCustomFieldSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCustomFieldSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCustomFieldSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCustomFieldSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCustomFieldSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCustomFieldSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCustomFieldSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCustomFieldSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCustomFieldSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCustomFieldSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCustomFieldSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCustomFieldSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &CustomFieldSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloneTodoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloneTodoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloneTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloneTodoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloneTodoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloneTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TodoTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TodoTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TodoTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &TodoTemplate_Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TodoTemplate_Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomFields == nil {
+				m.CustomFields = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTodo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTodo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTodo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTodo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTodo
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTodo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTodo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomFields[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueOffsetSeconds", wireType)
+			}
+			m.DueOffsetSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueOffsetSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subtasks = append(m.Subtasks, &TodoTemplate_Item{})
+			if err := m.Subtasks[len(m.Subtasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodoTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodoTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodoTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &TodoTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTodoTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTodoTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTodoTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTodoTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTodoTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTodoTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTodoTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTodoTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTodoTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &TodoTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTodoTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTodoTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTodoTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&UpdateTodosResponse{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTodo(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Todo) Unmarshal(dAtA []byte) error {
+func (m *ListTodoTemplatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +12508,17 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Todo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTodoTemplatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Todo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTodoTemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -1839,24 +12528,76 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, &TodoTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodoTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodoTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodoTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1881,11 +12622,111 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTodoTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTodoTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTodoTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstantiateTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantiateTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantiateTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1910,31 +12751,11 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTodo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Completed = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1958,18 +12779,68 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
+			if m.Base == nil {
+				m.Base = &types.Timestamp{}
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTodo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTodo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstantiateTemplateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTodo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantiateTemplateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantiateTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -1979,24 +12850,20 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &types.Timestamp{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2010,7 +12877,6 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2020,7 +12886,7 @@ func (m *Todo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportTodosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,17 +12909,17 @@ func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTodoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportTodosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -2063,24 +12929,20 @@ func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Todo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Calendar = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2094,7 +12956,6 @@ func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2104,7 +12965,7 @@ func (m *CreateTodoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportTodosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2127,15 +12988,15 @@ func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTodoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportTodosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2160,8 +13021,46 @@ func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTodo(dAtA[iNdEx:])
@@ -2174,7 +13073,6 @@ func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2184,7 +13082,7 @@ func (m *CreateTodoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
+func (m *QuickAddTodoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2207,17 +13105,17 @@ func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTodosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuickAddTodoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuickAddTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -2227,23 +13125,70 @@ func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Todo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTodo(dAtA[iNdEx:])
@@ -2256,7 +13201,6 @@ func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2266,7 +13210,7 @@ func (m *CreateTodosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
+func (m *QuickAddTodoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2289,15 +13233,15 @@ func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTodosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuickAddTodoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuickAddTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2322,7 +13266,71 @@ func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Todo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &QuickAddTodoResponse_Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2336,7 +13344,6 @@ func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2346,7 +13353,7 @@ func (m *CreateTodosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
+func (m *QuickAddTodoResponse_Token) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2369,15 +13376,15 @@ func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTodoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2402,7 +13409,36 @@ func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2416,7 +13452,6 @@ func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2426,7 +13461,7 @@ func (m *GetTodoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
+func (m *TodoEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2449,13 +13484,80 @@ func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTodoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TodoEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TodoEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TodoId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TodoId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (TodoEvent_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
@@ -2488,6 +13590,39 @@ func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTodo(dAtA[iNdEx:])
@@ -2500,7 +13635,6 @@ func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2510,7 +13644,7 @@ func (m *GetTodoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTodoRequest) Unmarshal(dAtA []byte) error {
+func (m *SnapshotHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2533,17 +13667,17 @@ func (m *ListTodoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTodoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatVersion", wireType)
 			}
-			m.Limit = 0
+			m.FormatVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -2553,16 +13687,16 @@ func (m *ListTodoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (int32(b) & 0x7F) << shift
+				m.FormatVersion |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotCompleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
 			}
-			var v int
+			m.SchemaVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTodo
@@ -2572,66 +13706,14 @@ func (m *ListTodoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.SchemaVersion |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NotCompleted = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTodo
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTodoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTodoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2655,65 +13737,95 @@ func (m *ListTodoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Todo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteTodoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Records = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Records |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTodoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTodo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2738,7 +13850,7 @@ func (m *DeleteTodoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Driver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2752,58 +13864,6 @@ func (m *DeleteTodoRequest) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteTodoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTodoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTodo
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -2813,7 +13873,7 @@ func (m *DeleteTodoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTodoRequest) Unmarshal(dAtA []byte) error {
+func (m *SnapshotRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,15 +13896,15 @@ func (m *UpdateTodoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTodoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTodoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Todo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2868,118 +13928,47 @@ func (m *UpdateTodoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Todo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Todo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Record = &SnapshotRecord_Todo{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTodo
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateTodoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeEntry", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTodoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTodoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTodo
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateTodosRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &TimeEntry{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTodosRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTodosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Record = &SnapshotRecord_TimeEntry{v}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomFieldSchema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3003,62 +13992,44 @@ func (m *UpdateTodosRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Todo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CustomFieldSchema{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Record = &SnapshotRecord_CustomFieldSchema{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTodo(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTodo
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTodo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateTodosResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTodo
+			if msglen < 0 {
+				return ErrInvalidLengthTodo
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &TodoTemplate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTodosResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTodosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Record = &SnapshotRecord_Template{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTodo(dAtA[iNdEx:])
@@ -3071,7 +14042,6 @@ func (m *UpdateTodosResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
 		}
 	}
@@ -3187,51 +14157,181 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/gofunct/gotasks/api/todo/v1/todo.proto", fileDescriptor_todo_8504e40ec3c575fb)
-}
-
-var fileDescriptor_todo_8504e40ec3c575fb = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xeb, 0x34, 0x69, 0x9b, 0x09, 0x6d, 0xd3, 0x69, 0x0a, 0xc6, 0x54, 0x26, 0xb8, 0x48,
-	0x54, 0x3d, 0xd8, 0x6a, 0x41, 0xa0, 0xf6, 0x56, 0x8a, 0xc4, 0xa5, 0x17, 0xd2, 0x72, 0x41, 0x54,
-	0x95, 0x13, 0x6f, 0xc3, 0xaa, 0xb1, 0xd7, 0xc4, 0xeb, 0x5c, 0x10, 0x12, 0xe2, 0x15, 0x38, 0xf1,
-	0x46, 0x3d, 0x22, 0x71, 0xe1, 0x48, 0x23, 0x1e, 0x04, 0x79, 0xbd, 0x8e, 0x37, 0xb1, 0x05, 0xed,
-	0x29, 0xd9, 0x9d, 0x99, 0x6f, 0x67, 0xe6, 0xff, 0x65, 0xd8, 0xed, 0x53, 0xfe, 0x21, 0xee, 0xda,
-	0x3d, 0xe6, 0x3b, 0x7d, 0x76, 0x11, 0x07, 0x3d, 0xee, 0xf4, 0x19, 0x77, 0xa3, 0xcb, 0xc8, 0x71,
-	0x43, 0xea, 0x70, 0xe6, 0x31, 0x67, 0xb4, 0x2b, 0x7e, 0xed, 0x70, 0xc8, 0x38, 0xc3, 0x45, 0xf1,
-	0x7f, 0xb4, 0x6b, 0x6c, 0xf6, 0x19, 0xeb, 0x0f, 0x88, 0xc8, 0x73, 0x83, 0x80, 0x71, 0x97, 0x53,
-	0x16, 0x44, 0x69, 0x9a, 0xf1, 0x50, 0x46, 0xc5, 0xa9, 0x1b, 0x5f, 0x38, 0x9c, 0xfa, 0x24, 0xe2,
-	0xae, 0x1f, 0xa6, 0x09, 0xd6, 0x58, 0x83, 0xea, 0x29, 0xf3, 0x18, 0xae, 0x40, 0x85, 0x7a, 0xba,
-	0xd6, 0xd6, 0xb6, 0xeb, 0x9d, 0x0a, 0xf5, 0xb0, 0x05, 0x35, 0x4e, 0xf9, 0x80, 0xe8, 0x15, 0x71,
-	0x95, 0x1e, 0xb0, 0x0d, 0x0d, 0x8f, 0x44, 0xbd, 0x21, 0x0d, 0x93, 0x57, 0xf4, 0x79, 0x11, 0x53,
-	0xaf, 0x70, 0x13, 0xea, 0x3d, 0xe6, 0x87, 0x03, 0xc2, 0x89, 0xa7, 0x57, 0xdb, 0xda, 0xf6, 0x52,
-	0x27, 0xbf, 0xc0, 0x7d, 0x80, 0xde, 0x90, 0xb8, 0x9c, 0x78, 0xe7, 0x2e, 0xd7, 0x6b, 0x6d, 0x6d,
-	0xbb, 0xb1, 0x67, 0xd8, 0x69, 0x93, 0x76, 0xd6, 0xa4, 0x7d, 0x9a, 0x35, 0xd9, 0xa9, 0xcb, 0xec,
-	0x43, 0x9e, 0x94, 0xc6, 0xa1, 0x97, 0x95, 0x2e, 0xfc, 0xbf, 0x54, 0x66, 0x1f, 0x72, 0xeb, 0x39,
-	0xac, 0x1d, 0x09, 0x4e, 0x32, 0x69, 0x87, 0x7c, 0x8c, 0x49, 0xc4, 0xf1, 0x11, 0x54, 0x29, 0x27,
-	0xbe, 0x18, 0xb9, 0xb1, 0xb7, 0x6c, 0xcb, 0x85, 0xda, 0x22, 0x47, 0x84, 0xac, 0xc7, 0x80, 0x6a,
-	0x5d, 0x14, 0xb2, 0x20, 0x22, 0xb3, 0x9b, 0xb2, 0xf6, 0xd5, 0xac, 0x28, 0xc3, 0x6f, 0x41, 0x2d,
-	0x61, 0x44, 0xba, 0xd6, 0x9e, 0x2f, 0xf2, 0xd3, 0x98, 0xf5, 0x04, 0xd6, 0xa7, 0x4a, 0xe5, 0x0b,
-	0x4d, 0x98, 0xa7, 0x5e, 0x5a, 0x59, 0xef, 0x24, 0x7f, 0xad, 0x36, 0xac, 0xbc, 0x26, 0x5c, 0x6d,
-	0x7f, 0xb6, 0x8b, 0x67, 0xb0, 0x3a, 0xc9, 0x90, 0x98, 0x1b, 0x4c, 0x78, 0x0c, 0xab, 0xc7, 0x34,
-	0x9a, 0x02, 0xb7, 0xa0, 0x36, 0xa0, 0x3e, 0xe5, 0xa2, 0xac, 0xd6, 0x49, 0x0f, 0xb8, 0x05, 0xcb,
-	0x01, 0xe3, 0xe7, 0xb9, 0xb4, 0x15, 0x21, 0xed, 0x9d, 0x80, 0xf1, 0xa3, 0xec, 0xce, 0x7a, 0x01,
-	0xcd, 0x9c, 0x26, 0x9b, 0xb8, 0xd1, 0x1e, 0xb6, 0x60, 0xed, 0x15, 0x49, 0x18, 0xff, 0x9a, 0xb0,
-	0x05, 0xa8, 0x26, 0xa5, 0xfc, 0x44, 0xdb, 0xb7, 0x42, 0xe8, 0x5b, 0x6a, 0xdb, 0x02, 0x54, 0xeb,
-	0x24, 0x6d, 0x5f, 0xbd, 0xbd, 0x9d, 0x96, 0x1b, 0xb0, 0x3e, 0x55, 0x9a, 0x12, 0xf7, 0xbe, 0xd7,
-	0xa0, 0x91, 0xdc, 0x9c, 0x90, 0xe1, 0x88, 0xf6, 0x08, 0x9e, 0x01, 0xe4, 0x92, 0xa3, 0x31, 0x41,
-	0x15, 0x0c, 0x6a, 0x3c, 0x28, 0x8d, 0xc9, 0x46, 0xef, 0x7e, 0xfd, 0xf9, 0xe7, 0x5b, 0xa5, 0x69,
-	0x2d, 0x65, 0xdf, 0x85, 0x03, 0x31, 0x16, 0x76, 0xa1, 0xa1, 0x38, 0x0a, 0xcb, 0x18, 0xd9, 0x58,
-	0xc6, 0x66, 0x79, 0x50, 0xbe, 0xa0, 0x8b, 0x17, 0xd0, 0x5a, 0xce, 0x5e, 0x70, 0xba, 0xf1, 0xe0,
-	0xf2, 0x40, 0xdb, 0xc1, 0x13, 0x58, 0x94, 0x56, 0xc3, 0x7b, 0x13, 0xc4, 0xb4, 0x3d, 0x0d, 0xbd,
-	0x18, 0x90, 0xdc, 0x0d, 0xc1, 0x5d, 0xc5, 0x9c, 0xfb, 0x89, 0x7a, 0x9f, 0xf1, 0x0d, 0x2c, 0x65,
-	0xde, 0xc1, 0xbc, 0x78, 0xc6, 0x9c, 0xc6, 0xfd, 0x92, 0x88, 0xe4, 0x36, 0x05, 0x17, 0x70, 0xb2,
-	0x11, 0x7c, 0x0f, 0x90, 0x1b, 0x46, 0x59, 0x75, 0xc1, 0x6a, 0xca, 0xaa, 0x4b, 0x1c, 0x26, 0x1b,
-	0xde, 0x99, 0x69, 0xf8, 0x0c, 0x20, 0xd7, 0x5b, 0xa1, 0x17, 0xdc, 0xa8, 0xd0, 0x4b, 0x1c, 0x27,
-	0x85, 0x34, 0x4a, 0x84, 0x54, 0xec, 0x84, 0x65, 0x8c, 0x12, 0x21, 0x4b, 0x1c, 0x98, 0x09, 0x69,
-	0x14, 0x84, 0x7c, 0x69, 0x5e, 0x5d, 0x9b, 0x73, 0xbf, 0xae, 0xcd, 0xb9, 0x2f, 0x63, 0x53, 0xbb,
-	0x1a, 0x9b, 0xda, 0x8f, 0xb1, 0xa9, 0xfd, 0x1e, 0x9b, 0xda, 0xbb, 0x6a, 0x92, 0xd7, 0x5d, 0x10,
-	0x9f, 0xd5, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x35, 0x34, 0xa2, 0xa0, 0x06, 0x00,
-	0x00,
+	proto.RegisterFile("github.com/gofunct/gotasks/api/todo/v1/todo.proto", fileDescriptor_todo_16a3fb6055739238)
+}
+
+var fileDescriptor_todo_16a3fb6055739238 = []byte{
+	// 2741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x39, 0xcd, 0x73, 0xdb, 0xc6,
+	0xf5, 0x02, 0xbf, 0xf9, 0xf4, 0x45, 0xae, 0x14, 0x19, 0x82, 0x14, 0x59, 0x86, 0x9c, 0x44, 0x51,
+	0xfc, 0x23, 0x23, 0xfb, 0x37, 0x69, 0xe2, 0x4e, 0xda, 0x91, 0x2d, 0xd9, 0xe6, 0x8c, 0xc7, 0x1f,
+	0x10, 0x9d, 0x43, 0x9a, 0x94, 0x03, 0x01, 0x2b, 0x19, 0x15, 0x09, 0x30, 0xc0, 0x42, 0x09, 0x93,
+	0x7a, 0x26, 0x93, 0xde, 0x7a, 0xea, 0x4c, 0xdb, 0x3f, 0xa6, 0x87, 0xde, 0x7a, 0xc8, 0x21, 0x87,
+	0xcc, 0xb4, 0xd3, 0xc9, 0xb1, 0xb1, 0x7b, 0xee, 0xb5, 0xc7, 0x76, 0x76, 0xb1, 0x0b, 0x2c, 0x08,
+	0x90, 0xb2, 0x92, 0x5e, 0x24, 0xec, 0x7b, 0x6f, 0xdf, 0x7b, 0xfb, 0xf6, 0xed, 0xfb, 0x22, 0xec,
+	0x9e, 0x38, 0xe4, 0x69, 0x78, 0xd4, 0xb2, 0xbc, 0x41, 0xfb, 0xc4, 0x3b, 0x0e, 0x5d, 0x8b, 0xb4,
+	0x4f, 0x3c, 0x62, 0x06, 0xa7, 0x41, 0xdb, 0x1c, 0x3a, 0x6d, 0xe2, 0xd9, 0x5e, 0xfb, 0x6c, 0x97,
+	0xfd, 0x6f, 0x0d, 0x7d, 0x8f, 0x78, 0xa8, 0xca, 0xbe, 0xcf, 0x76, 0xb5, 0xf5, 0x13, 0xcf, 0x3b,
+	0xe9, 0x63, 0x46, 0x67, 0xba, 0xae, 0x47, 0x4c, 0xe2, 0x78, 0x6e, 0x10, 0x91, 0x69, 0x97, 0x39,
+	0x96, 0xad, 0x8e, 0xc2, 0xe3, 0x36, 0x71, 0x06, 0x38, 0x20, 0xe6, 0x60, 0x18, 0x11, 0xe8, 0x7f,
+	0x29, 0x43, 0xa9, 0xeb, 0xd9, 0x1e, 0x5a, 0x80, 0x82, 0x63, 0xab, 0xca, 0xa6, 0xb2, 0x5d, 0x37,
+	0x0a, 0x8e, 0x8d, 0x96, 0xa1, 0x4c, 0x1c, 0xd2, 0xc7, 0x6a, 0x81, 0x81, 0xa2, 0x05, 0xda, 0x84,
+	0x59, 0x1b, 0x07, 0x96, 0xef, 0x0c, 0xa9, 0x14, 0xb5, 0xc8, 0x70, 0x32, 0x08, 0xad, 0x43, 0xdd,
+	0xf2, 0x06, 0xc3, 0x3e, 0x26, 0xd8, 0x56, 0x4b, 0x9b, 0xca, 0x76, 0xcd, 0x48, 0x00, 0xe8, 0x3d,
+	0x00, 0xcb, 0xc7, 0x26, 0xc1, 0x76, 0xcf, 0x24, 0x6a, 0x79, 0x53, 0xd9, 0x9e, 0xbd, 0xae, 0xb5,
+	0x22, 0x25, 0x5b, 0x42, 0xc9, 0x56, 0x57, 0x28, 0x69, 0xd4, 0x39, 0xf5, 0x1e, 0xa1, 0x5b, 0xc3,
+	0xa1, 0x2d, 0xb6, 0x56, 0xce, 0xdf, 0xca, 0xa9, 0xf7, 0x08, 0x5a, 0x81, 0x4a, 0x40, 0x4c, 0x12,
+	0x06, 0x6a, 0x95, 0x29, 0xcc, 0x57, 0x08, 0x41, 0x89, 0x98, 0x27, 0x81, 0x5a, 0xdb, 0x2c, 0x6e,
+	0xd7, 0x0d, 0xf6, 0x4d, 0x61, 0x7d, 0x27, 0x20, 0x6a, 0x9d, 0x51, 0xb2, 0x6f, 0xf4, 0x06, 0x2c,
+	0x12, 0xdf, 0xb4, 0x4e, 0xb1, 0xdd, 0x0b, 0xb0, 0xe5, 0xb9, 0x76, 0xa0, 0xc2, 0xa6, 0xb2, 0x5d,
+	0x34, 0x16, 0x38, 0xf8, 0x30, 0x82, 0xa2, 0x7d, 0x98, 0xb7, 0xc2, 0x80, 0x78, 0x83, 0xde, 0xb1,
+	0x83, 0xfb, 0x76, 0xa0, 0xce, 0x6e, 0x16, 0xb7, 0x67, 0xaf, 0x5f, 0x6e, 0xf1, 0xdb, 0x6a, 0x51,
+	0x53, 0xb7, 0x6e, 0x33, 0x92, 0x3b, 0x8c, 0xe2, 0xc0, 0x25, 0xfe, 0xc8, 0x98, 0xb3, 0x24, 0x10,
+	0x5a, 0x83, 0xfa, 0xd0, 0xf4, 0xb1, 0x4b, 0x7a, 0x8e, 0xad, 0xce, 0x31, 0x3d, 0x6a, 0x11, 0xa0,
+	0x63, 0xa3, 0x5d, 0xa8, 0xd8, 0x21, 0xa6, 0x26, 0x98, 0x3f, 0xd7, 0x04, 0x65, 0x3b, 0xc4, 0x7b,
+	0x04, 0xbd, 0x09, 0x0d, 0xe9, 0x86, 0x7a, 0x4f, 0xc9, 0xa0, 0xaf, 0x2e, 0x30, 0xb6, 0x8b, 0x12,
+	0xfc, 0x1e, 0x19, 0xf4, 0xd1, 0x4d, 0x98, 0xa7, 0x6e, 0xd7, 0x1b, 0xfa, 0xde, 0x89, 0x8f, 0x83,
+	0x40, 0x5d, 0x64, 0x42, 0x5e, 0x49, 0x0e, 0x60, 0x06, 0xa7, 0x8f, 0x38, 0xd2, 0x98, 0x23, 0xd2,
+	0x0a, 0x6d, 0x00, 0xf8, 0xd8, 0x0a, 0x7d, 0x1f, 0xbb, 0x16, 0x56, 0x1b, 0x4c, 0x80, 0x04, 0x41,
+	0xab, 0x50, 0x73, 0x2c, 0xb3, 0xdf, 0x0b, 0x1d, 0x5b, 0x6d, 0x32, 0x6c, 0x95, 0xae, 0x9f, 0x38,
+	0xb6, 0xf6, 0x73, 0x68, 0x66, 0x8c, 0x82, 0x1a, 0x50, 0x3c, 0xc5, 0x23, 0xee, 0x92, 0xf4, 0x93,
+	0xfa, 0xe4, 0x99, 0xd9, 0x0f, 0x63, 0x9f, 0x64, 0x8b, 0x9b, 0x85, 0x77, 0x15, 0xfd, 0x5d, 0x98,
+	0x93, 0x35, 0xa3, 0xb7, 0x68, 0x7b, 0x2e, 0x66, 0x9b, 0xcb, 0x06, 0xfb, 0xa6, 0xbb, 0x89, 0x47,
+	0xcc, 0x3e, 0xdb, 0x5d, 0x36, 0xa2, 0x85, 0xfe, 0x2f, 0x05, 0xea, 0xd4, 0x62, 0x91, 0xcc, 0xf1,
+	0x57, 0x70, 0x09, 0xd8, 0x43, 0xa3, 0x17, 0x11, 0xc9, 0xac, 0xd0, 0x65, 0x87, 0x21, 0xc2, 0x00,
+	0xfb, 0x14, 0x11, 0x3d, 0x82, 0x0a, 0x5d, 0x76, 0x98, 0x87, 0x07, 0xc4, 0xf4, 0xb9, 0x9b, 0x96,
+	0xce, 0x77, 0x53, 0x4e, 0x1d, 0x79, 0x78, 0x40, 0xbc, 0xe1, 0xf0, 0xa5, 0x1f, 0x07, 0xa7, 0xe6,
+	0x57, 0x1c, 0xfa, 0xec, 0xe9, 0xc7, 0x2e, 0x5a, 0x61, 0x2e, 0xba, 0x28, 0xe0, 0xdc, 0x47, 0xf5,
+	0x77, 0xa0, 0x79, 0x9b, 0x3d, 0x2a, 0xea, 0x8b, 0x06, 0xfe, 0x24, 0xc4, 0x01, 0x41, 0x57, 0xa0,
+	0xe4, 0x10, 0x3c, 0x60, 0x27, 0x9f, 0xbd, 0x3e, 0x9f, 0xf2, 0x57, 0x83, 0xa1, 0xf4, 0xab, 0x80,
+	0xe4, 0x7d, 0xc1, 0xd0, 0x73, 0x03, 0x3c, 0x6e, 0x30, 0xfd, 0x3d, 0x99, 0x2a, 0x10, 0xec, 0xb7,
+	0xa0, 0x4c, 0x79, 0x04, 0xaa, 0xb2, 0x59, 0xcc, 0xf2, 0x8f, 0x70, 0xfa, 0x1b, 0xb0, 0x94, 0xda,
+	0xca, 0x25, 0x34, 0xa0, 0xe8, 0xd8, 0xd1, 0xce, 0xba, 0x41, 0x3f, 0xf5, 0x0f, 0x61, 0xe1, 0x2e,
+	0x26, 0xb2, 0xfa, 0xe3, 0xd7, 0xb6, 0x02, 0x15, 0x1f, 0xbb, 0x36, 0xf6, 0xc5, 0xad, 0x45, 0x2b,
+	0x1a, 0xbe, 0x2c, 0xcf, 0x0d, 0x9c, 0x80, 0x60, 0xd7, 0x1a, 0x89, 0xf0, 0x25, 0x81, 0xf4, 0xff,
+	0x87, 0xc5, 0x98, 0x37, 0x57, 0xe0, 0x25, 0x6c, 0xf3, 0x55, 0x01, 0x16, 0xef, 0x3b, 0x41, 0x4a,
+	0xa7, 0x65, 0x28, 0xf7, 0x9d, 0x81, 0x43, 0xb8, 0x0f, 0x46, 0x0b, 0xb4, 0x05, 0xf3, 0xae, 0x47,
+	0x7a, 0x49, 0x88, 0x2c, 0xb0, 0x10, 0x39, 0xe7, 0x7a, 0xe4, 0xb6, 0x80, 0xc5, 0x31, 0xa8, 0x28,
+	0xc5, 0xa0, 0xb7, 0x61, 0x59, 0x0e, 0x2d, 0xbd, 0x63, 0xa7, 0x4f, 0xb0, 0x1f, 0xa8, 0x25, 0x66,
+	0x17, 0x24, 0x05, 0x90, 0x3b, 0x11, 0x86, 0xbe, 0x37, 0xcf, 0xb7, 0xb1, 0xdf, 0x3b, 0x1a, 0x31,
+	0x67, 0xaa, 0x1b, 0x55, 0xb6, 0xbe, 0x35, 0x92, 0xec, 0x53, 0x99, 0x66, 0x9f, 0x6a, 0xc6, 0x3e,
+	0x94, 0xa9, 0x79, 0x4c, 0x22, 0xc7, 0xaf, 0x45, 0x4c, 0xd9, 0xba, 0x63, 0xeb, 0x3f, 0x81, 0x46,
+	0x62, 0x03, 0x6e, 0xbb, 0x97, 0xba, 0xf8, 0x2d, 0x68, 0xee, 0x63, 0x7a, 0xf2, 0x29, 0x57, 0xaa,
+	0x2f, 0x03, 0x92, 0x89, 0x22, 0xfe, 0xfa, 0xeb, 0x32, 0x34, 0x76, 0xb7, 0xac, 0xcb, 0xb4, 0x61,
+	0x29, 0x45, 0xc7, 0xd5, 0x53, 0xa1, 0x6a, 0xe3, 0xe8, 0x1e, 0xa2, 0x5b, 0x12, 0x4b, 0xfd, 0x6f,
+	0x0a, 0x68, 0xd2, 0x8e, 0x5b, 0xa3, 0xc8, 0xaa, 0x42, 0xc2, 0x6b, 0xb0, 0xe0, 0xb9, 0xfd, 0x91,
+	0x74, 0x8f, 0x0a, 0xbb, 0xc7, 0x79, 0x0a, 0x4d, 0x2e, 0x32, 0x49, 0x3c, 0x85, 0xf1, 0xc4, 0xf3,
+	0x3f, 0xb8, 0x60, 0x15, 0xaa, 0x96, 0xe7, 0x1e, 0x3b, 0xfe, 0x80, 0xdd, 0x6f, 0xcd, 0x10, 0x4b,
+	0x1a, 0x9d, 0x6c, 0x7f, 0xd4, 0xf3, 0x43, 0x97, 0x5d, 0x70, 0xcd, 0xa8, 0xd8, 0xfe, 0xc8, 0x08,
+	0x5d, 0xfd, 0x06, 0xac, 0xe5, 0x9e, 0x8a, 0xdb, 0x63, 0x19, 0xca, 0x96, 0x17, 0xba, 0xb1, 0xcf,
+	0xb2, 0x05, 0x8d, 0x18, 0x4f, 0x58, 0x2e, 0xbd, 0x60, 0xc4, 0x58, 0x06, 0x24, 0xef, 0xe3, 0x57,
+	0xf6, 0x58, 0x86, 0x5e, 0x28, 0x42, 0x44, 0xe6, 0xf4, 0x1d, 0x8b, 0xf0, 0x57, 0xc3, 0x57, 0xfa,
+	0x1f, 0x0a, 0xb0, 0x94, 0xe2, 0xc9, 0x8f, 0xf3, 0x3e, 0x54, 0x7d, 0x1c, 0x84, 0x7d, 0x22, 0xd8,
+	0x6e, 0xc5, 0x6c, 0x73, 0xc8, 0x5b, 0x06, 0xa3, 0x35, 0xc4, 0x1e, 0xed, 0xcf, 0x0a, 0x54, 0x22,
+	0x58, 0x26, 0xc0, 0xdc, 0x4a, 0x5d, 0xec, 0xc2, 0xf5, 0x9d, 0x97, 0x60, 0xdc, 0x3a, 0x64, 0x3b,
+	0x62, 0x27, 0x58, 0x86, 0x32, 0xf6, 0x7d, 0xcf, 0xe7, 0x5e, 0x10, 0x2d, 0xf4, 0x0e, 0x54, 0x22,
+	0x3a, 0xb4, 0x02, 0xe8, 0xb0, 0xbb, 0xd7, 0x7d, 0x72, 0xd8, 0x7b, 0xf2, 0xe0, 0xf0, 0xd1, 0xc1,
+	0xed, 0xce, 0x9d, 0xce, 0xc1, 0x7e, 0x63, 0x06, 0xcd, 0x42, 0xf5, 0xc9, 0xa3, 0xfd, 0xbd, 0xee,
+	0xc1, 0x7e, 0x43, 0x41, 0xf3, 0x50, 0x7f, 0xf0, 0xb0, 0xdb, 0xbb, 0xf3, 0xf0, 0xc9, 0x83, 0xfd,
+	0x46, 0x81, 0xe2, 0x3a, 0x0f, 0x3e, 0xd8, 0xbb, 0xdf, 0xd9, 0x6f, 0x14, 0xf5, 0x03, 0x68, 0x1e,
+	0xd2, 0xe4, 0x42, 0x33, 0x46, 0xec, 0xb9, 0x52, 0x46, 0x53, 0x26, 0x65, 0xb4, 0x82, 0x9c, 0xd1,
+	0xf4, 0x9f, 0x01, 0x92, 0xd9, 0x70, 0xdb, 0x6e, 0x43, 0x19, 0xd3, 0x94, 0xc9, 0x1d, 0x00, 0x25,
+	0x17, 0x26, 0x92, 0xa9, 0x11, 0x11, 0xe8, 0x6f, 0x41, 0xe3, 0x90, 0x78, 0xc3, 0x71, 0x2d, 0x84,
+	0x30, 0x25, 0x25, 0xec, 0x7d, 0x68, 0x4a, 0xc4, 0x17, 0x96, 0xb5, 0x0b, 0x2b, 0x2c, 0x06, 0x71,
+	0xb8, 0x83, 0x83, 0xf3, 0xce, 0xad, 0xdf, 0x85, 0x4b, 0x99, 0x2d, 0x5c, 0xee, 0x35, 0xa8, 0xe2,
+	0x08, 0xc4, 0xfd, 0x27, 0x4f, 0xb2, 0x20, 0xd1, 0xff, 0xae, 0x40, 0x93, 0x82, 0x0d, 0x3c, 0xf4,
+	0x7c, 0x22, 0xe4, 0xb6, 0xa0, 0x74, 0xec, 0x7b, 0xe2, 0x9d, 0x4c, 0x4b, 0xe7, 0x8c, 0x0e, 0xed,
+	0x40, 0x81, 0x78, 0x6a, 0xe1, 0x5c, 0xea, 0x02, 0xf1, 0xd0, 0xfb, 0x50, 0x3b, 0xf1, 0xbd, 0x70,
+	0x48, 0x23, 0x7c, 0x91, 0xf9, 0xa1, 0x9e, 0x52, 0x30, 0xa5, 0x49, 0xeb, 0x2e, 0x25, 0xbd, 0x35,
+	0x32, 0xaa, 0x27, 0xd1, 0x87, 0xfe, 0x3a, 0x54, 0x39, 0x0c, 0xd5, 0xa0, 0xd4, 0x7d, 0xb8, 0xff,
+	0xb0, 0x31, 0x83, 0xaa, 0x50, 0xec, 0xee, 0xdd, 0x6d, 0x28, 0x14, 0x74, 0xbf, 0x73, 0xd8, 0x6d,
+	0x14, 0xf4, 0x5f, 0x03, 0x92, 0xb9, 0x71, 0xe3, 0xdc, 0x80, 0x92, 0xef, 0x7d, 0x2a, 0x2c, 0x73,
+	0x39, 0x57, 0xb0, 0xf0, 0x7f, 0xef, 0x53, 0x83, 0x11, 0x6b, 0xbb, 0x50, 0x34, 0xbc, 0x4f, 0x73,
+	0x4a, 0x3b, 0x15, 0xaa, 0xa2, 0x6e, 0x29, 0xb0, 0xba, 0x45, 0x2c, 0xf5, 0xcf, 0x53, 0xb5, 0xe1,
+	0xa1, 0xf5, 0x14, 0x0f, 0xcc, 0x38, 0x80, 0x2a, 0x52, 0x00, 0xa5, 0xd1, 0x81, 0x61, 0xe3, 0x60,
+	0x1b, 0xd1, 0xa6, 0x1b, 0x87, 0xe2, 0x05, 0x1a, 0x07, 0xfd, 0x01, 0x6c, 0x1a, 0xf8, 0x84, 0xe6,
+	0x3e, 0x3f, 0xa3, 0x83, 0xb8, 0xe0, 0x0b, 0xa8, 0xa2, 0x6f, 0xc1, 0x95, 0x29, 0xfc, 0x78, 0x80,
+	0xdc, 0x85, 0xb5, 0xbb, 0x98, 0x5c, 0x44, 0x9e, 0x6e, 0xc0, 0x7a, 0xfe, 0x16, 0x7e, 0x57, 0xd7,
+	0x63, 0x7d, 0x84, 0x1b, 0x8a, 0xdb, 0xca, 0xee, 0x11, 0xba, 0xea, 0xd0, 0xb8, 0xdd, 0xf7, 0xdc,
+	0xa9, 0x49, 0x79, 0x0b, 0x9a, 0x12, 0xcd, 0x84, 0x92, 0xf0, 0xcb, 0x12, 0xcc, 0x51, 0x82, 0x2e,
+	0x1e, 0x0c, 0xfb, 0x26, 0xc9, 0x10, 0xd0, 0x13, 0xb9, 0xe6, 0x40, 0x54, 0xf5, 0xec, 0x3b, 0x37,
+	0x43, 0xb6, 0x78, 0xca, 0x29, 0x8d, 0x9d, 0x41, 0x66, 0xde, 0xea, 0x10, 0x3c, 0x88, 0xf2, 0xcf,
+	0x8f, 0x68, 0x36, 0xb5, 0x6f, 0x0a, 0x50, 0xa2, 0x9c, 0x92, 0x36, 0x58, 0x99, 0xd2, 0x06, 0x17,
+	0xb2, 0x6d, 0xb0, 0x68, 0x2d, 0x8b, 0x52, 0x6b, 0xf9, 0x78, 0xbc, 0x3b, 0x2c, 0xb1, 0xa7, 0x73,
+	0x6d, 0xf2, 0x41, 0xce, 0x6d, 0x15, 0xaf, 0x01, 0xa2, 0xdd, 0xa0, 0x77, 0x7c, 0x1c, 0x60, 0x12,
+	0x57, 0xfe, 0x65, 0xf6, 0x82, 0x1a, 0x76, 0x88, 0x1f, 0x32, 0x84, 0x68, 0x4f, 0xdf, 0x81, 0x5a,
+	0x10, 0x1e, 0xb1, 0xb9, 0x82, 0x5a, 0xd9, 0x2c, 0x9e, 0x63, 0xc4, 0x98, 0xf6, 0xc7, 0xb7, 0x67,
+	0x0f, 0x60, 0x35, 0x29, 0xed, 0x85, 0x14, 0xe1, 0x54, 0xbb, 0x50, 0x23, 0x1c, 0xa4, 0x2a, 0xe3,
+	0xed, 0xa6, 0x4c, 0x1f, 0x93, 0xe9, 0xd7, 0x40, 0xcb, 0xe3, 0x37, 0xc1, 0x01, 0xb7, 0x61, 0x85,
+	0xd7, 0xf4, 0xe3, 0xa2, 0xc7, 0x29, 0xef, 0xc3, 0xa5, 0x0c, 0x25, 0x67, 0xfa, 0x03, 0xb4, 0xd4,
+	0x40, 0x15, 0x05, 0xb1, 0xc0, 0x8a, 0x74, 0xa4, 0x3f, 0x82, 0xd5, 0x1c, 0x5c, 0x1c, 0x5a, 0xeb,
+	0x82, 0x89, 0x88, 0xaf, 0x13, 0x84, 0x25, 0x74, 0xfa, 0x5b, 0xb0, 0x9a, 0x94, 0x76, 0xe7, 0x1d,
+	0x74, 0x1d, 0xb4, 0x3c, 0x62, 0x1e, 0x81, 0x3e, 0x02, 0xad, 0xe3, 0x06, 0xc4, 0x74, 0x89, 0x43,
+	0x6d, 0x3c, 0x9d, 0x17, 0x7d, 0x96, 0x47, 0x66, 0x80, 0x5f, 0x22, 0x67, 0x31, 0x3a, 0xbd, 0x0d,
+	0x6b, 0xb9, 0xdc, 0x27, 0xf6, 0x7b, 0x6f, 0x03, 0xea, 0x0c, 0x68, 0x42, 0x49, 0x55, 0x8c, 0x1a,
+	0xd4, 0x2c, 0xb3, 0x8f, 0x5d, 0xdb, 0xf4, 0xb9, 0x32, 0xf1, 0x5a, 0xff, 0x05, 0x2c, 0xa5, 0x76,
+	0x4c, 0x62, 0xcd, 0x4a, 0xe8, 0xe8, 0xd1, 0xf3, 0xa9, 0x80, 0x58, 0x52, 0x0c, 0xcf, 0x03, 0x2c,
+	0x06, 0x95, 0x0d, 0xb1, 0xd4, 0x7b, 0xb0, 0xf4, 0x38, 0x74, 0xac, 0xd3, 0x3d, 0xdb, 0x96, 0x63,
+	0x23, 0x7d, 0xf1, 0xf8, 0xb3, 0x38, 0x2e, 0xd3, 0x6f, 0x3a, 0xc9, 0x21, 0xce, 0x00, 0xf7, 0x3e,
+	0xa7, 0xb3, 0x88, 0xe8, 0x55, 0xd4, 0x28, 0xe0, 0x43, 0x3a, 0x8f, 0x90, 0x8a, 0xf4, 0x62, 0xaa,
+	0x48, 0xff, 0x93, 0x02, 0xcb, 0x69, 0x09, 0xf9, 0x8e, 0x1d, 0xd7, 0xe0, 0x85, 0x89, 0x35, 0x38,
+	0xfa, 0x29, 0x54, 0x88, 0x77, 0x8a, 0xdd, 0x28, 0x12, 0xc9, 0x15, 0x70, 0x9e, 0x84, 0x56, 0x97,
+	0xd2, 0x1a, 0x7c, 0x8b, 0xb6, 0x0b, 0x65, 0x06, 0xc8, 0x3d, 0xdb, 0x32, 0x94, 0x59, 0x18, 0x13,
+	0xaf, 0x9d, 0x2d, 0xa2, 0x71, 0x8a, 0x67, 0x7b, 0x07, 0x67, 0xd8, 0x95, 0x5d, 0xa5, 0x38, 0x7d,
+	0x9c, 0xf2, 0x16, 0x94, 0xc8, 0x68, 0x88, 0x79, 0x15, 0x73, 0x29, 0x75, 0x12, 0xc6, 0xaa, 0xd5,
+	0x1d, 0x0d, 0xb1, 0xc1, 0x88, 0xd0, 0x95, 0x54, 0x1e, 0xc8, 0x3d, 0xf6, 0x0f, 0x0f, 0xfd, 0xfa,
+	0xff, 0x41, 0x89, 0xca, 0xa2, 0xa5, 0xf4, 0x6d, 0xe3, 0x60, 0xaf, 0x9b, 0xad, 0xb9, 0x67, 0xa1,
+	0xba, 0x7f, 0x70, 0xff, 0x80, 0x2e, 0x0a, 0xfa, 0x7f, 0x14, 0x58, 0x38, 0x74, 0xcd, 0x61, 0xf0,
+	0xd4, 0x23, 0xf7, 0xb0, 0x49, 0xbb, 0xe8, 0xd7, 0x60, 0xe1, 0xd8, 0xf3, 0x07, 0x26, 0xe9, 0x9d,
+	0x61, 0x3f, 0xa0, 0x09, 0x22, 0x6a, 0xa7, 0xe6, 0x23, 0xe8, 0x07, 0x11, 0x90, 0x92, 0x45, 0xa9,
+	0x36, 0x26, 0x8b, 0x2a, 0x9f, 0xf9, 0x08, 0x2a, 0xc8, 0xd2, 0x47, 0x29, 0x5e, 0xe0, 0x28, 0xec,
+	0x89, 0x3c, 0xc5, 0xd6, 0x69, 0x10, 0x46, 0xc6, 0x9a, 0x33, 0xe2, 0x35, 0xf5, 0x6f, 0x1f, 0x5b,
+	0x9e, 0x1f, 0xa7, 0x0b, 0xb1, 0xa4, 0xc5, 0x0b, 0xc1, 0xae, 0xe9, 0x12, 0x31, 0x1c, 0x88, 0x56,
+	0x14, 0x6e, 0xfb, 0xce, 0x19, 0xf6, 0xc5, 0x14, 0x35, 0x5a, 0xe9, 0xff, 0x96, 0x2c, 0x60, 0x30,
+	0x1e, 0x68, 0x0b, 0x4a, 0xf4, 0x52, 0x72, 0x9b, 0xc3, 0x7b, 0x33, 0x06, 0x43, 0xa2, 0x1b, 0x00,
+	0xec, 0x71, 0x44, 0xa5, 0x7d, 0x61, 0x52, 0x69, 0x7f, 0x6f, 0xc6, 0xa8, 0x13, 0xb1, 0x40, 0xf7,
+	0x61, 0x29, 0xd5, 0x25, 0xf3, 0xb2, 0xa6, 0x78, 0x5e, 0x59, 0x73, 0x6f, 0xc6, 0x68, 0x5a, 0xe3,
+	0x40, 0x74, 0x43, 0x0a, 0xea, 0xa5, 0x29, 0x41, 0xfd, 0xde, 0x4c, 0x12, 0xd6, 0x6f, 0xd5, 0xe8,
+	0xf0, 0x84, 0x1e, 0xf3, 0xfa, 0x37, 0x4b, 0x30, 0x4b, 0xc9, 0x0e, 0xb1, 0x7f, 0xe6, 0x58, 0x18,
+	0x7d, 0x0c, 0x90, 0xa4, 0x25, 0x24, 0x69, 0x33, 0x3e, 0x6f, 0xd3, 0xd6, 0x72, 0x71, 0x3c, 0xfc,
+	0xae, 0x7c, 0xf5, 0xd7, 0x7f, 0xfe, 0xbe, 0xd0, 0xb8, 0x19, 0x75, 0xd1, 0x35, 0x31, 0xf9, 0x47,
+	0x47, 0x30, 0x9b, 0x50, 0x07, 0x28, 0x8f, 0x87, 0x88, 0x8e, 0xda, 0x7a, 0x3e, 0x92, 0x4b, 0x50,
+	0x99, 0x04, 0x74, 0x53, 0xd9, 0xd1, 0xe7, 0x05, 0xfb, 0xf6, 0x51, 0xd8, 0x3f, 0x45, 0x87, 0x50,
+	0xe5, 0x19, 0x10, 0x25, 0xaf, 0x30, 0x3d, 0x6d, 0xd3, 0xd4, 0x2c, 0x82, 0xf3, 0x7d, 0x85, 0xf1,
+	0x5d, 0x44, 0x09, 0xd3, 0x2f, 0x1c, 0xfb, 0x19, 0x7a, 0x0c, 0x35, 0x91, 0xec, 0x50, 0xb2, 0x79,
+	0x6c, 0x60, 0xa6, 0xad, 0xe6, 0x60, 0x38, 0xdf, 0x06, 0xe3, 0x0b, 0x28, 0xb1, 0x45, 0x0f, 0x66,
+	0xa5, 0x41, 0x86, 0x64, 0x8b, 0xec, 0x38, 0x48, 0x5b, 0xcf, 0x47, 0xa6, 0x75, 0xde, 0x19, 0x33,
+	0xc4, 0x47, 0x00, 0x09, 0xb5, 0x74, 0x97, 0x99, 0x49, 0x95, 0xb6, 0x96, 0x8b, 0x9b, 0xc8, 0x9d,
+	0x59, 0xc4, 0x87, 0xa5, 0x9c, 0x39, 0x0c, 0xda, 0xca, 0xd3, 0x74, 0x6c, 0xf6, 0xa4, 0x5d, 0x9d,
+	0x4e, 0x94, 0x36, 0xd9, 0x4e, 0x62, 0xb2, 0x8f, 0x01, 0x92, 0xe1, 0x84, 0x74, 0xa2, 0xcc, 0x6c,
+	0x47, 0x5b, 0xcb, 0xc5, 0xe5, 0x79, 0xa7, 0x96, 0xf2, 0xce, 0x84, 0x5a, 0xbe, 0x91, 0xec, 0xb4,
+	0x47, 0x5b, 0xcf, 0x47, 0x66, 0xbc, 0x53, 0x1b, 0xbb, 0x94, 0x5f, 0x01, 0x24, 0xa3, 0x08, 0xe9,
+	0x08, 0x99, 0x31, 0x87, 0xb6, 0x96, 0x8b, 0xe3, 0x02, 0xb6, 0x98, 0x80, 0x57, 0xa9, 0xfb, 0xab,
+	0xc9, 0xbd, 0xf0, 0xcc, 0xf4, 0x8c, 0xfd, 0x46, 0xe6, 0xa3, 0x8f, 0xa1, 0x1e, 0x4f, 0x22, 0xd0,
+	0xaa, 0xc4, 0x2e, 0x3d, 0xca, 0xd0, 0xb4, 0x3c, 0x14, 0x17, 0xb4, 0xca, 0x04, 0x2d, 0x51, 0x41,
+	0x0b, 0x4c, 0x10, 0xc5, 0xb6, 0x03, 0xe2, 0x0d, 0x51, 0xc8, 0x27, 0xc6, 0xc9, 0xd8, 0x01, 0x5d,
+	0x4e, 0x3f, 0x80, 0xcc, 0x0c, 0x43, 0xdb, 0x9c, 0x4c, 0xc0, 0x05, 0x5e, 0x66, 0x02, 0x57, 0xd1,
+	0xa5, 0x09, 0xc7, 0x42, 0xbf, 0x04, 0x48, 0x1a, 0x74, 0xc9, 0x82, 0x99, 0x71, 0x81, 0xb6, 0x96,
+	0x8b, 0xe3, 0x72, 0x2e, 0x31, 0x39, 0x4d, 0xb4, 0x28, 0x4e, 0xd5, 0xf6, 0x23, 0x8e, 0x7f, 0x54,
+	0x60, 0x75, 0x62, 0x8b, 0x8b, 0xde, 0x8c, 0x79, 0x9e, 0xd7, 0x56, 0x6b, 0x3b, 0x2f, 0x43, 0xca,
+	0xb5, 0xb9, 0xc2, 0xb4, 0x59, 0xa3, 0x0e, 0xb3, 0x42, 0x15, 0xa2, 0xdd, 0x62, 0xfb, 0x0b, 0xfa,
+	0xf7, 0x59, 0x9b, 0x0f, 0x01, 0x7e, 0xa3, 0xc0, 0x72, 0x5e, 0x8b, 0x8c, 0xae, 0xca, 0xc1, 0x6c,
+	0xa2, 0x36, 0xaf, 0x9d, 0x43, 0xc5, 0x15, 0xd9, 0x60, 0x8a, 0xa8, 0x68, 0x92, 0x16, 0x47, 0x50,
+	0x8f, 0xfb, 0x65, 0xc9, 0xa7, 0xc6, 0xfb, 0x6c, 0x4d, 0xcb, 0x43, 0xa5, 0x65, 0x50, 0x9f, 0x5a,
+	0x4a, 0x05, 0x95, 0xb6, 0x45, 0x69, 0xd1, 0x33, 0xf9, 0x17, 0x98, 0xb8, 0xe7, 0xd6, 0x73, 0xf2,
+	0xc1, 0x58, 0x61, 0xaf, 0x6d, 0x4d, 0xa5, 0x19, 0x13, 0x9f, 0xb4, 0x39, 0x73, 0x4c, 0x0b, 0x21,
+	0xc8, 0x8b, 0x7f, 0x40, 0x89, 0x65, 0x5f, 0x1e, 0xcf, 0x17, 0xe3, 0x82, 0x37, 0x27, 0x13, 0xa4,
+	0x1f, 0x12, 0x6a, 0xca, 0xb2, 0xa2, 0x50, 0x3a, 0x84, 0x66, 0xa6, 0x93, 0x42, 0x57, 0x32, 0xb9,
+	0x64, 0xbc, 0x03, 0xd3, 0xf4, 0x69, 0x24, 0x5c, 0xec, 0x32, 0x13, 0xbb, 0x80, 0xd2, 0x47, 0xfc,
+	0x4c, 0xfe, 0xd1, 0x21, 0xc7, 0xc2, 0x13, 0xdb, 0x30, 0x6d, 0x6b, 0x2a, 0x4d, 0xfa, 0xac, 0x3b,
+	0x39, 0x67, 0xfd, 0xad, 0x02, 0x4b, 0x39, 0xbd, 0x93, 0x94, 0x37, 0x26, 0xf7, 0x6d, 0xda, 0xd5,
+	0xe9, 0x44, 0x5c, 0xfa, 0x36, 0x93, 0xae, 0x53, 0xf7, 0x7a, 0x35, 0xa3, 0x40, 0xdb, 0x49, 0x76,
+	0x22, 0x07, 0xe6, 0xe4, 0x1e, 0x02, 0xad, 0x4f, 0x68, 0x2d, 0x22, 0xe9, 0xaf, 0x4e, 0x6d, 0x3c,
+	0xf4, 0x75, 0x26, 0x76, 0x85, 0x8a, 0x6d, 0x0a, 0xaf, 0xbe, 0xf9, 0x09, 0xa7, 0x44, 0x36, 0xcc,
+	0x4a, 0xfd, 0x9c, 0x94, 0x5b, 0xb2, 0x7d, 0xa1, 0xb6, 0x9e, 0x8f, 0xe4, 0x72, 0x34, 0x26, 0x67,
+	0x99, 0xca, 0x59, 0x8c, 0x5f, 0x8f, 0xc3, 0x08, 0x6f, 0xed, 0x7c, 0xfd, 0xfd, 0xc6, 0xcc, 0x77,
+	0xdf, 0x6f, 0xcc, 0x7c, 0xf9, 0x7c, 0x43, 0xf9, 0xfa, 0xf9, 0x86, 0xf2, 0xed, 0xf3, 0x0d, 0xe5,
+	0x1f, 0xcf, 0x37, 0x94, 0xdf, 0xbd, 0xd8, 0x98, 0xf9, 0xf6, 0xc5, 0xc6, 0xcc, 0x77, 0x2f, 0x36,
+	0x66, 0x3e, 0x64, 0xc5, 0xeb, 0x51, 0x85, 0x55, 0xde, 0x37, 0xfe, 0x3b, 0x00, 0x1b, 0x6a, 0xe3,
+	0x05, 0xbb, 0x21, 0x00, 0x00,
 }
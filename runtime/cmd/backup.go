@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/grpc"
+	"github.com/gofunct/gotasks/runtime/snapshot"
+	"github.com/gofunct/gotasks/runtime/storage"
+	vi "github.com/gofunct/gotasks/runtime/viper"
+	"github.com/spf13/cobra"
+)
+
+var backupTenant string
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup [file]",
+	Short: "write a snapshot of the todos, time entries, custom field schemas and templates to a file",
+	Long: `Write a snapshot of the database of the config to a file. The snapshot is a
+compressed file of length delimited protobuf records, with a header holding the
+format and schema versions and a checksum of the records. It can be restored by
+gotasks restore to a database of any driver. Encrypted fields are kept
+encrypted.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, repo, version, err := openSnapshotRepository(backupTenant)
+		if err != nil {
+			return err
+		}
+		f, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		header, err := snapshot.Backup(ctx, repo, f, todo.SnapshotHeader{
+			SchemaVersion: version,
+			Tenant:        backupTenant,
+			Driver:        driver(),
+		})
+		if err != nil {
+			f.Close()
+			os.Remove(args[0])
+			return err
+		}
+		fmt.Println("wrote", header.Records, "records to", args[0])
+		return f.Close()
+	},
+}
+
+var (
+	restoreTenant     string
+	restoreOnConflict string
+	restoreBatchSize  int
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [file]",
+	Short: "restore a snapshot written by gotasks backup",
+	Long: `Verify a snapshot written by gotasks backup, then upsert its records into the
+database of the config, empty or not. The todos are restored in batches of one
+transaction each. --on-conflict tells what to do with the records already in
+the database: error aborts the restore, skip keeps the rows of the database and
+overwrite replaces them with the records of the snapshot.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, repo, version, err := openSnapshotRepository(restoreTenant)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		res, err := snapshot.Restore(ctx, repo, f, snapshot.Options{
+			OnConflict:    snapshot.Policy(restoreOnConflict),
+			BatchSize:     restoreBatchSize,
+			Driver:        driver(),
+			SchemaVersion: version,
+		})
+		if res != nil {
+			fmt.Printf("restored %d todos, %d time entries, %d custom field schemas and %d templates, skipped %d records\n",
+				res.Todos, res.TimeEntries, res.Schemas, res.Templates, res.Skipped)
+		}
+		return err
+	},
+}
+
+// openSnapshotRepository opens the storage backend of the config without
+// its decorators, so that the snapshots hold the rows as stored, and
+// returns the highest migration applied to it. As the snapshots do not
+// record the tenant of each todo, the databases isolating tenants are
+// backed up and restored one tenant at a time.
+func openSnapshotRepository(tenant string) (context.Context, storage.Repository, int64, error) {
+	ctx := context.Background()
+	repo, err := grpc.OpenRepository()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	switch role := vi.VString("db_tenant_role"); {
+	case tenant != "" && role == "":
+		return nil, nil, 0, fmt.Errorf("--tenant requires db_tenant_role")
+	case tenant == "" && role != "":
+		return nil, nil, 0, fmt.Errorf("db_tenant_role is set, --tenant is required")
+	case tenant != "":
+		ctx = storage.WithTenant(ctx, tenant)
+	}
+	var version int64
+	if m, ok := repo.(grpc.Migratable); ok {
+		status, err := m.Migrator().Status(ctx)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		for _, s := range status {
+			if s.Applied && int64(s.Version) > version {
+				version = int64(s.Version)
+			}
+		}
+	}
+	return ctx, repo, version, nil
+}
+
+// driver returns the db_driver of the config, postgres by default.
+func driver() string {
+	if d := vi.VString("db_driver"); d != "" {
+		return d
+	}
+	return "postgres"
+}
+
+func init() {
+	backupCmd.Flags().StringVar(&backupTenant, "tenant", "", "only back up the todos of a tenant, with db_tenant_role")
+	restoreCmd.Flags().StringVar(&restoreTenant, "tenant", "", "restore the todos to a tenant, with db_tenant_role")
+	restoreCmd.Flags().StringVar(&restoreOnConflict, "on-conflict", string(snapshot.Fail), "what to do with the records already in the database: error, skip or overwrite")
+	restoreCmd.Flags().IntVar(&restoreBatchSize, "batch-size", 100, "number of todos restored per transaction")
+	RootCmd.AddCommand(backupCmd, restoreCmd)
+}
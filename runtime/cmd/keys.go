@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofunct/gotasks/runtime/grpc"
+	"github.com/gofunct/gotasks/runtime/storage/encryption"
+	"github.com/gofunct/gotasks/runtime/storage/postgres"
+	"github.com/spf13/cobra"
+)
+
+// keysCmd represents the keys command
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "manage the keys encrypting the fields of the todos",
+}
+
+var keysRotateBatchSize int
+
+var keysRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "encrypt the fields of the todos again with the primary key of encryption_key_file",
+	Long: `Encrypt again the fields of the todos stored in clear or with another key
+than the primary key of encryption_key_file. The todos are rotated in batches,
+the service keeps serving them during the rotation. Keys can be removed from
+the key file once the rotation is done.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		repo, err := grpc.OpenRepository()
+		if err != nil {
+			return err
+		}
+		// The rotation covers the todos of every tenant
+		if pg, ok := repo.(*postgres.Repository); ok {
+			repo = pg.WithTenantRole("")
+		}
+		encrypted, err := grpc.NewEncryption(repo)
+		if err != nil {
+			return err
+		}
+		r, ok := encrypted.(*encryption.Repository)
+		if !ok {
+			return fmt.Errorf("encryption_key_file is not set")
+		}
+		rotated, err := r.Rotate(context.Background(), keysRotateBatchSize)
+		fmt.Println("rotated", rotated, "todos")
+		return err
+	},
+}
+
+func init() {
+	keysRotateCmd.Flags().IntVar(&keysRotateBatchSize, "batch-size", 100, "number of todos rotated per transaction")
+	keysCmd.AddCommand(keysRotateCmd)
+	RootCmd.AddCommand(keysCmd)
+}
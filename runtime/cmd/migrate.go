@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/gofunct/gotasks/runtime/grpc"
+	"github.com/gofunct/gotasks/runtime/migrate"
+	vi "github.com/gofunct/gotasks/runtime/viper"
+	"github.com/spf13/cobra"
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate the schema of the database of the config",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "apply the pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(m migrate.Migrator) error {
+			done, err := m.Up(context.Background())
+			for _, migration := range done {
+				fmt.Println("applied", migration)
+			}
+			return err
+		})
+	},
+}
+
+var migrateDownSteps int
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "revert the last applied migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(m migrate.Migrator) error {
+			done, err := m.Down(context.Background(), migrateDownSteps)
+			for _, migration := range done {
+				fmt.Println("reverted", migration)
+			}
+			return err
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "list the migrations and whether they are applied",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(m migrate.Migrator) error {
+			status, err := m.Status(context.Background())
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+			for _, s := range status {
+				state := "pending"
+				if s.Applied {
+					state = "applied"
+				}
+				fmt.Fprintf(w, "%s\t%s\n", s.Migration, state)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+var migrateCreateDir string
+
+var migrateCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "write the up and down files of a new migration of the db_driver of the config",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := migrateCreateDir
+		if dir == "" {
+			driver := vi.VString("db_driver")
+			if driver == "" {
+				driver = "postgres"
+			}
+			dir = filepath.Join("runtime", "storage", driver, "migrations")
+		}
+		paths, err := migrate.Create(dir, args[0])
+		for _, p := range paths {
+			fmt.Println("created", p)
+		}
+		return err
+	},
+}
+
+// withMigrator calls f with the migrator of the database of the config.
+func withMigrator(f func(m migrate.Migrator) error) error {
+	repo, err := grpc.OpenRepository()
+	if err != nil {
+		return err
+	}
+	m, ok := repo.(grpc.Migratable)
+	if !ok {
+		return fmt.Errorf("the %s driver has no schema to migrate", vi.VString("db_driver"))
+	}
+	return f(m.Migrator())
+}
+
+func init() {
+	migrateDownCmd.Flags().IntVar(&migrateDownSteps, "steps", 1, "number of migrations to revert")
+	migrateCreateCmd.Flags().StringVar(&migrateCreateDir, "dir", "", "directory of the migrations (default runtime/storage/<db_driver>/migrations)")
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateCreateCmd)
+	RootCmd.AddCommand(migrateCmd)
+}
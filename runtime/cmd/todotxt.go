@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/todotxt"
+	vi "github.com/gofunct/gotasks/runtime/viper"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// todotxtCmd represents the todotxt command
+var todotxtCmd = &cobra.Command{
+	Use:   "todotxt",
+	Short: "import and export todo items in the todo.txt format",
+}
+
+var todotxtImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "create the todo items of a todo.txt file, read from stdin without file",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var r io.Reader = os.Stdin
+		if len(args) == 1 {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			r = f
+		}
+		return withClient(func(client api.TodoServiceClient) error {
+			ids, err := todotxt.Import(context.Background(), client, r)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "imported %d items\n", len(ids))
+			return nil
+		})
+	},
+}
+
+var todotxtExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "write every todo item in the todo.txt format, to stdout without file",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var w io.Writer = os.Stdout
+		if len(args) == 1 {
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		return withClient(func(client api.TodoServiceClient) error {
+			return todotxt.Export(context.Background(), client, w)
+		})
+	},
+}
+
+// withClient calls f with a client of the grpc server of the config.
+func withClient(f func(client api.TodoServiceClient) error) error {
+	conn, err := grpc.Dial(vi.VString("grpc_port"), grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return f(api.NewTodoServiceClient(conn))
+}
+
+func init() {
+	todotxtCmd.AddCommand(todotxtImportCmd, todotxtExportCmd)
+	RootCmd.AddCommand(todotxtCmd)
+}
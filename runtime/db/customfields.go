@@ -0,0 +1,227 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/gogo/protobuf/types"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var customFieldKey = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// RegisterCustomFieldSchema registers or replaces the JSON Schema of a list
+func (s Store) RegisterCustomFieldSchema(ctx context.Context, req *todo.RegisterCustomFieldSchemaRequest) (*todo.RegisterCustomFieldSchemaResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	if req.List == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not register schema: missing list")
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(req.Schema)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not register schema: %s", err)
+	}
+	if err := stringProperties(req.Schema); err != nil {
+		return nil, err
+	}
+	schema := &todo.CustomFieldSchema{
+		List:      req.List,
+		Schema:    req.Schema,
+		UpdatedAt: types.TimestampNow(),
+	}
+	err := s.Repo.PutCustomFieldSchema(ctx, schema)
+	if err != nil {
+		return nil, dbError(err, "Could not insert schema")
+	}
+	return &todo.RegisterCustomFieldSchemaResponse{}, nil
+}
+
+// stringProperties checks that the properties of a schema accept strings,
+// the type of every custom field, so that the schema can be satisfied.
+func stringProperties(schema string) error {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "Could not register schema: %s", err)
+	}
+	var v validation.Violations
+	check := func(field string, property interface{}) {
+		p, ok := property.(map[string]interface{})
+		if !ok {
+			return
+		}
+		var types []interface{}
+		switch t := p["type"].(type) {
+		case nil:
+			return
+		case []interface{}:
+			types = t
+		default:
+			types = []interface{}{t}
+		}
+		for _, t := range types {
+			if t == "string" {
+				return
+			}
+		}
+		v.Add(field, "has type %v, custom fields are strings", p["type"])
+	}
+	for _, key := range []string{"properties", "patternProperties"} {
+		properties, _ := doc[key].(map[string]interface{})
+		for name, property := range properties {
+			check("schema."+key+"."+name, property)
+		}
+	}
+	check("schema.additionalProperties", doc["additionalProperties"])
+	sort.Slice(v, func(i, j int) bool { return v[i].Field < v[j].Field })
+	return v.Err()
+}
+
+// GetCustomFieldSchema retrieves the JSON Schema of a list
+func (s Store) GetCustomFieldSchema(ctx context.Context, req *todo.GetCustomFieldSchemaRequest) (*todo.GetCustomFieldSchemaResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	schemas, err := s.Repo.CustomFieldSchemas(ctx, req.List)
+	if err != nil {
+		return nil, dbError(err, "Could not retrieve schema")
+	}
+	if len(schemas) == 0 {
+		return nil, grpc.Errorf(codes.NotFound, "Could not retrieve schema: no schema registered for list %s", req.List)
+	}
+	return &todo.GetCustomFieldSchemaResponse{Schema: schemas[0]}, nil
+}
+
+// validateCustomFields validates the custom fields of the items against
+// the schemas of their lists. Items of lists without schema are not checked.
+// Each failing field is reported as a violation of the field path of its
+// item in the request, given by path.
+func (s Store) validateCustomFields(ctx context.Context, path func(i int) string, items ...*todo.Todo) error {
+	violations, err := s.customFieldViolations(ctx, items)
+	if err != nil {
+		return err
+	}
+	var all validation.Violations
+	for i := range items {
+		for _, v := range violations[i] {
+			all.Add(path(i)+v.Field, "%s", v.Description)
+		}
+	}
+	return all.Err()
+}
+
+// itemPath and itemsPath are the field paths of the items of the requests
+// with a single item and with a batch of items.
+func itemPath(int) string {
+	return "item."
+}
+
+func itemsPath(i int) string {
+	return fmt.Sprintf("items[%d].", i)
+}
+
+// customFieldViolations returns the schema violations of each item, indexed
+// by the position of the item, with field paths relative to the item.
+func (s Store) customFieldViolations(ctx context.Context, items []*todo.Todo) (map[int]validation.Violations, error) {
+	lists := map[string]*gojsonschema.Schema{}
+	for _, item := range items {
+		if item.List != "" {
+			lists[item.List] = nil
+		}
+	}
+	if len(lists) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(lists))
+	for list := range lists {
+		names = append(names, list)
+	}
+	schemas, err := s.Repo.CustomFieldSchemas(ctx, names...)
+	if err != nil {
+		return nil, dbError(err, "Could not retrieve schemas")
+	}
+	for _, schema := range schemas {
+		compiled, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema.Schema))
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "Could not load schema of list %s: %s", schema.List, err)
+		}
+		lists[schema.List] = compiled
+	}
+
+	violations := map[int]validation.Violations{}
+	for i, item := range items {
+		schema := lists[item.List]
+		if schema == nil {
+			continue
+		}
+		fields := make(map[string]interface{}, len(item.CustomFields))
+		for k, v := range item.CustomFields {
+			fields[k] = v
+		}
+		res, err := schema.Validate(gojsonschema.NewGoLoader(fields))
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "Could not validate custom fields: %s", err)
+		}
+		for _, e := range res.Errors() {
+			v := violations[i]
+			v.Add(customFieldPath(e), "%s", e.Description())
+			violations[i] = v
+		}
+	}
+	return violations, nil
+}
+
+// customFieldPath returns the path of the field of a schema violation. The
+// missing required fields are reported at their own path rather than at the
+// root of the custom fields.
+func customFieldPath(e gojsonschema.ResultError) string {
+	field := e.Field()
+	if property, ok := e.Details()["property"].(string); ok && e.Type() == "required" {
+		field = property
+	}
+	if field == gojsonschema.STRING_CONTEXT_ROOT {
+		return "custom_fields"
+	}
+	return "custom_fields." + field
+}
+
+// parseCustomFieldFilters splits custom field filters formatted as key=value.
+func parseCustomFieldFilters(filters []string) ([][2]string, error) {
+	parsed := make([][2]string, 0, len(filters))
+	for _, filter := range filters {
+		kv := strings.SplitN(filter, "=", 2)
+		if len(kv) != 2 || !customFieldKey.MatchString(kv[0]) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Invalid custom field filter %q: expected key=value", filter)
+		}
+		parsed = append(parsed, [2]string{kv[0], kv[1]})
+	}
+	return parsed, nil
+}
+
+// parseOrder parses the sort order of a list request, a todo field or
+// a custom field prefixed with - for descending order.
+func parseOrder(orderBy string) (storage.Order, error) {
+	var order storage.Order
+	if orderBy == "" {
+		return order, nil
+	}
+	order.Field = orderBy
+	if strings.HasPrefix(order.Field, "-") {
+		order.Field, order.Desc = order.Field[1:], true
+	}
+	switch field := order.Field; {
+	case field == "id", field == "title", field == "status", field == "created_at", field == "updated_at":
+	case strings.HasPrefix(field, "custom_fields.") && customFieldKey.MatchString(strings.TrimPrefix(field, "custom_fields.")):
+	default:
+		return order, grpc.Errorf(codes.InvalidArgument, "Invalid order_by %q", orderBy)
+	}
+	return order, nil
+}
@@ -2,105 +2,349 @@ package db
 
 import (
 	"context"
-	"github.com/go-pg/pg"
 	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/markdown"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/gofunct/gotasks/runtime/workflow"
 	"github.com/gogo/protobuf/types"
 	"github.com/satori/go.uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
 )
 
 // Store is the service dealing with storing
 // and retrieving todo items from the database.
 type Store struct {
-	DB *pg.DB
+	// Repo is the storage backend the items are kept in.
+	Repo storage.Repository
+	// Workflow defines the allowed statuses and transitions,
+	// the default todo/done workflow is used when nil.
+	Workflow *workflow.Workflow
+	// Clock returns the current time the dates of quick added
+	// items are evaluated against, time.Now when nil.
+	Clock func() time.Time
+}
+
+func (s Store) workflow() *workflow.Workflow {
+	if s.Workflow == nil {
+		return workflow.Default()
+	}
+	return s.Workflow
+}
+
+// initStatus sets the status of a new item and derives its completed flag.
+func (s Store) initStatus(item *todo.Todo) error {
+	wf := s.workflow()
+	item.Status = wf.Resolve(item.Status, item.Completed)
+	if !wf.Has(item.Status) {
+		return grpc.Errorf(codes.InvalidArgument, "Unknown status %q", item.Status)
+	}
+	item.Completed = wf.IsTerminal(item.Status)
+	return nil
+}
+
+// transition moves an item from its current status to the requested one
+// and derives its completed flag. Clients that only know about the completed
+// flag move the item to the done or initial status when toggling it, whether
+// they leave the status empty or send back the current one.
+func (s Store) transition(current, item *todo.Todo) error {
+	wf := s.workflow()
+	from := wf.Resolve(current.Status, current.Completed)
+	if item.Status == "" || item.Status == from && item.Completed != wf.IsTerminal(from) {
+		switch {
+		case item.Completed && !wf.IsTerminal(from):
+			item.Status = wf.Done()
+		case !item.Completed && wf.IsTerminal(from):
+			item.Status = wf.Initial
+		default:
+			item.Status = from
+		}
+	}
+	if !wf.Has(item.Status) {
+		return grpc.Errorf(codes.InvalidArgument, "Unknown status %q", item.Status)
+	}
+	if !wf.CanTransition(from, item.Status) {
+		return grpc.Errorf(codes.FailedPrecondition, "Could not update item %s: illegal transition from %q to %q", item.Id, from, item.Status)
+	}
+	item.Completed = wf.IsTerminal(item.Status)
+	return nil
 }
 
 // CreateTodo creates a todo given a description
 func (s Store) CreateTodo(ctx context.Context, req *todo.CreateTodoRequest) (*todo.CreateTodoResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
 	req.Item.Id = uuid.NewV4().String()
-	err := s.DB.Insert(req.Item)
+	if err := s.initStatus(req.Item); err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomFields(ctx, itemPath, req.Item); err != nil {
+		return nil, err
+	}
+	err := s.Repo.CreateTodos(ctx, req.Item)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Could not insert item into the database: %s", err)
+		return nil, dbError(err, "Could not insert item")
 	}
 	return &todo.CreateTodoResponse{Id: req.Item.Id}, nil
 }
 
 // CreateTodos create todo items from a list of todo descriptions
 func (s Store) CreateTodos(ctx context.Context, req *todo.CreateTodosRequest) (*todo.CreateTodosResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
 	var ids []string
 	for _, item := range req.Items {
 		item.Id = uuid.NewV4().String()
+		if err := s.initStatus(item); err != nil {
+			return nil, err
+		}
 		ids = append(ids, item.Id)
 	}
-	err := s.DB.Insert(&req.Items)
+	if err := s.validateCustomFields(ctx, itemsPath, req.Items...); err != nil {
+		return nil, err
+	}
+	err := s.Repo.CreateTodos(ctx, req.Items...)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Could not insert items into the database: %s", err)
+		return nil, dbError(err, "Could not insert items")
 	}
 	return &todo.CreateTodosResponse{Ids: ids}, nil
 }
 
 // GetTodo retrieves a todo item from its ID
 func (s Store) GetTodo(ctx context.Context, req *todo.GetTodoRequest) (*todo.GetTodoResponse, error) {
-	var item todo.Todo
-	err := s.DB.Model(&item).Where("id = ?", req.Id).First()
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	if req.Consistency != storage.ConsistencyStrong {
+		ctx = storage.AllowStale(ctx)
+	}
+	item, err := s.Repo.GetTodo(ctx, req.Id)
 	if err != nil {
-		return nil, grpc.Errorf(codes.NotFound, "Could not retrieve item from the database: %s", err)
+		return nil, dbError(err, "Could not retrieve item")
+	}
+	if err := markdown.Apply(req.Render, item); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Could not render description: %s", err)
 	}
-	return &todo.GetTodoResponse{Item: &item}, nil
+	return &todo.GetTodoResponse{Item: item}, nil
 }
 
 // ListTodo retrieves a todo item from its ID
 func (s Store) ListTodo(ctx context.Context, req *todo.ListTodoRequest) (*todo.ListTodoResponse, error) {
-	var items []*todo.Todo
-	query := s.DB.Model(&items).Order("created_at ASC")
-	if req.Limit > 0 {
-		query.Limit(int(req.Limit))
+	if err := validation.Request(req); err != nil {
+		return nil, err
 	}
-	if req.NotCompleted {
-		query.Where("completed = false")
+	filters, err := parseCustomFieldFilters(req.CustomFieldFilters)
+	if err != nil {
+		return nil, err
 	}
-	err := query.Select()
+	order, err := parseOrder(req.OrderBy)
 	if err != nil {
-		return nil, grpc.Errorf(codes.NotFound, "Could not list items from the database: %s", err)
+		return nil, err
+	}
+	if req.Consistency != storage.ConsistencyStrong {
+		ctx = storage.AllowStale(ctx)
+	}
+	items, err := s.Repo.ListTodos(ctx, storage.Filter{
+		NotCompleted: req.NotCompleted,
+		List:         req.List,
+		CustomFields: filters,
+		AfterID:      req.AfterId,
+		Order:        order,
+		Limit:        int(req.Limit),
+	})
+	if err != nil {
+		return nil, dbError(err, "Could not list items")
+	}
+	if err := markdown.Apply(req.Render, items...); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Could not render descriptions: %s", err)
 	}
 	return &todo.ListTodoResponse{Items: items}, nil
 }
 
 // DeleteTodo deletes a todo given an ID
 func (s Store) DeleteTodo(ctx context.Context, req *todo.DeleteTodoRequest) (*todo.DeleteTodoResponse, error) {
-	err := s.DB.Delete(&todo.Todo{Id: req.Id})
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	_, err := s.Repo.DeleteTodos(ctx, storage.Filter{IDs: []string{req.Id}})
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Could not delete item from the database: %s", err)
+		return nil, dbError(err, "Could not delete item")
 	}
 	return &todo.DeleteTodoResponse{}, nil
 }
 
+// DeleteTodos deletes todo items and their time entries given their IDs
+func (s Store) DeleteTodos(ctx context.Context, req *todo.DeleteTodosRequest) (*todo.DeleteTodosResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return &todo.DeleteTodosResponse{}, nil
+	}
+	deleted, err := s.Repo.DeleteTodos(ctx, storage.Filter{IDs: req.Ids})
+	if err != nil {
+		return nil, dbError(err, "Could not delete items")
+	}
+	return &todo.DeleteTodosResponse{Deleted: int32(deleted)}, nil
+}
+
+// DeleteTodosByFilter deletes the todo items matching a filter and their time entries.
+// The deletion has to be confirmed unless it is a dry run only counting the matching items.
+func (s Store) DeleteTodosByFilter(ctx context.Context, req *todo.DeleteTodosByFilterRequest) (*todo.DeleteTodosByFilterResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	if !req.OnlyCompleted && req.Status == "" && req.List == "" && len(req.CustomFieldFilters) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not delete items: empty filter")
+	}
+	if !req.Confirm && !req.DryRun {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Could not delete items: deletion must be confirmed")
+	}
+	filters, err := parseCustomFieldFilters(req.CustomFieldFilters)
+	if err != nil {
+		return nil, err
+	}
+	filter := storage.Filter{
+		OnlyCompleted: req.OnlyCompleted,
+		Status:        req.Status,
+		List:          req.List,
+		CustomFields:  filters,
+	}
+
+	if req.DryRun {
+		count, err := s.Repo.CountTodos(ctx, filter)
+		if err != nil {
+			return nil, dbError(err, "Could not count items")
+		}
+		return &todo.DeleteTodosByFilterResponse{Count: int32(count)}, nil
+	}
+
+	deleted, err := s.Repo.DeleteTodos(ctx, filter)
+	if err != nil {
+		return nil, dbError(err, "Could not delete items")
+	}
+	return &todo.DeleteTodosByFilterResponse{Count: int32(deleted)}, nil
+}
+
 // UpdateTodo updates a todo item
 func (s Store) UpdateTodo(ctx context.Context, req *todo.UpdateTodoRequest) (*todo.UpdateTodoResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
 	req.Item.UpdatedAt = types.TimestampNow()
-	res, err := s.DB.Model(req.Item).Column("title", "description", "completed", "updated_at").Update()
-	if res.RowsAffected() == 0 {
-		return nil, grpc.Errorf(codes.NotFound, "Could not update item: not found")
+	if err := s.validateCustomFields(ctx, itemPath, req.Item); err != nil {
+		return nil, err
 	}
+	err := s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		current, err := tx.LockTodos(ctx, req.Item.Id)
+		if err != nil {
+			return dbError(err, "Could not update item")
+		}
+		if len(current) == 0 {
+			return grpc.Errorf(codes.NotFound, "Could not update item: not found")
+		}
+		if err := s.transition(current[0], req.Item); err != nil {
+			return err
+		}
+		err = tx.UpdateTodos(ctx, req.Item)
+		if err != nil {
+			return dbError(err, "Could not update item")
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Could not update item from the database: %s", err)
+		return nil, dbError(err, "Could not update item")
 	}
 	return &todo.UpdateTodoResponse{}, nil
 }
 
 // UpdateTodos updates todo items given their respective title and description.
+// Every item gets a result telling whether it was updated, not found or invalid.
+// In strict mode, nothing is updated if any item fails.
 func (s Store) UpdateTodos(ctx context.Context, req *todo.UpdateTodosRequest) (*todo.UpdateTodosResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
 	time := types.TimestampNow()
+	ids := make([]string, 0, len(req.Items))
 	for _, item := range req.Items {
 		item.UpdatedAt = time
+		ids = append(ids, item.Id)
 	}
-	res, err := s.DB.Model(&req.Items).Column("title", "description", "completed", "updated_at").Update()
-	if res.RowsAffected() == 0 {
-		return nil, grpc.Errorf(codes.NotFound, "Could not update items: not found")
+	violations, err := s.customFieldViolations(ctx, req.Items)
+	if err != nil {
+		return nil, err
 	}
+
+	results := make([]*todo.UpdateTodosResponse_Result, len(req.Items))
+	err = s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		current, err := tx.LockTodos(ctx, ids...)
+		if err != nil {
+			return dbError(err, "Could not update items")
+		}
+		byID := make(map[string]*todo.Todo, len(current))
+		for _, item := range current {
+			byID[item.Id] = item
+		}
+
+		var failed []string
+		var invalid validation.Violations
+		for i, item := range req.Items {
+			result := &todo.UpdateTodosResponse_Result{Id: item.Id}
+			results[i] = result
+			cur, ok := byID[item.Id]
+			if !ok {
+				result.Status = todo.UpdateTodosResponse_Result_NOT_FOUND
+				failed = append(failed, item.Id+": not found")
+				continue
+			}
+			if v := append(validation.Todo("", item, true), violations[i]...); len(v) > 0 {
+				result.Status = todo.UpdateTodosResponse_Result_INVALID
+				descriptions := make([]string, len(v))
+				for j, violation := range v {
+					descriptions[j] = violation.Field + ": " + violation.Description
+					invalid.Add(itemsPath(i)+violation.Field, "%s", violation.Description)
+				}
+				result.Error = "Invalid item: " + strings.Join(descriptions, "; ")
+				failed = append(failed, item.Id+": "+result.Error)
+				continue
+			}
+			if err := s.transition(cur, item); err != nil {
+				result.Status = todo.UpdateTodosResponse_Result_INVALID
+				result.Error = status.Convert(err).Message()
+				failed = append(failed, item.Id+": "+result.Error)
+				continue
+			}
+			result.Status = todo.UpdateTodosResponse_Result_UPDATED
+		}
+		if req.Strict && len(failed) > 0 {
+			// The invalid fields are detailed as with the other requests
+			st := status.New(codes.FailedPrecondition, "Could not update items: "+strings.Join(failed, "; "))
+			if len(invalid) > 0 {
+				if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: invalid}); err == nil {
+					st = detailed
+				}
+			}
+			return st.Err()
+		}
+
+		updated := make([]*todo.Todo, 0, len(req.Items))
+		for i, item := range req.Items {
+			if results[i].Status == todo.UpdateTodosResponse_Result_UPDATED {
+				updated = append(updated, item)
+			}
+		}
+		return dbError(tx.UpdateTodos(ctx, updated...), "Could not update items")
+	})
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Could not update items from the database: %s", err)
+		return nil, dbError(err, "Could not update items")
 	}
-	return &todo.UpdateTodosResponse{}, nil
+	return &todo.UpdateTodosResponse{Results: results}, nil
 }
@@ -2,19 +2,38 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-pg/pg"
-	"github.com/go-pg/pg/orm"
 	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/outbox"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/cache"
+	"github.com/gofunct/gotasks/runtime/storage/encryption"
+	"github.com/gofunct/gotasks/runtime/storage/memory"
+	"github.com/gofunct/gotasks/runtime/storage/postgres"
+	"github.com/gofunct/gotasks/runtime/storage/sqlite"
+	"github.com/gofunct/gotasks/runtime/workflow"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TodoSuite struct {
 	suite.Suite
 	Todo *Store
+	// NewRepo returns an empty repository for each test.
+	NewRepo func() storage.Repository
+	// Cleanup releases the repository of a test, when set.
+	Cleanup func()
 }
 
 func TestTodoTestSuite(t *testing.T) {
@@ -27,17 +46,97 @@ func TestTodoTestSuite(t *testing.T) {
 		MinRetryBackoff:       250 * time.Millisecond,
 	})
 	suite.Run(t, &TodoSuite{
-		Todo: &Store{DB: db},
+		Todo: &Store{},
+		NewRepo: func() storage.Repository {
+			repo := postgres.New(db)
+			repo.Migrator().Down(context.Background(), len(postgres.Migrations))
+			if _, err := repo.Migrator().Up(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			return repo
+		},
+		Cleanup: func() { postgres.New(db).Migrator().Down(context.Background(), len(postgres.Migrations)) },
+	})
+}
+
+func TestTodoSQLiteSuite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotasks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var repo *sqlite.Repository
+	suite.Run(t, &TodoSuite{
+		Todo: &Store{},
+		NewRepo: func() storage.Repository {
+			os.Remove(filepath.Join(dir, "test.db"))
+			repo, err = sqlite.Open(filepath.Join(dir, "test.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := repo.Migrator().Up(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			return repo
+		},
+		Cleanup: func() { repo.Close() },
+	})
+}
+
+func TestTodoMemorySuite(t *testing.T) {
+	suite.Run(t, &TodoSuite{
+		Todo:    &Store{},
+		NewRepo: func() storage.Repository { return memory.New() },
+	})
+}
+
+// TestTodoCacheSuite checks that every write invalidates the cache read by
+// GetTodo and ListTodo.
+func TestTodoCacheSuite(t *testing.T) {
+	suite.Run(t, &TodoSuite{
+		Todo: &Store{},
+		NewRepo: func() storage.Repository {
+			return cache.New(memory.New(), cache.Options{Size: 100, TTL: time.Minute})
+		},
+	})
+}
+
+// TestTodoOutboxSuite checks that recording the changes in the outbox does
+// not change the behavior of the service.
+func TestTodoOutboxSuite(t *testing.T) {
+	suite.Run(t, &TodoSuite{
+		Todo:    &Store{},
+		NewRepo: func() storage.Repository { return outbox.New(memory.New()) },
+	})
+}
+
+// TestTodoEncryptionSuite checks that the encryption of the fields is
+// transparent to the service.
+func TestTodoEncryptionSuite(t *testing.T) {
+	keys, err := encryption.NewKeyring("k1", map[string][]byte{"k1": make([]byte, 32)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	suite.Run(t, &TodoSuite{
+		Todo: &Store{},
+		NewRepo: func() storage.Repository {
+			repo, err := encryption.New(memory.New(), keys, encryption.Fields...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return repo
+		},
 	})
 }
 
 func (s *TodoSuite) SetupTest() {
-	s.Todo.DB.DropTable(&api.Todo{}, &orm.DropTableOptions{IfExists: true})
-	s.Todo.DB.CreateTable(&api.Todo{}, nil)
+	s.Todo.Repo = s.NewRepo()
 }
 
 func (s *TodoSuite) TearDownTest() {
-	s.Todo.DB.DropTable(&api.Todo{}, &orm.DropTableOptions{IfExists: true})
+	if s.Cleanup != nil {
+		s.Cleanup()
+	}
 }
 
 func (s *TodoSuite) TestCreateTodo() {
@@ -53,6 +152,15 @@ func (s *TodoSuite) TestCreateTodo() {
 	assert.Nil(s.T(), err)
 	assert.NotNil(s.T(), rcreate)
 	assert.NotEqual(s.T(), rcreate.Id, "")
+
+	// The Store validates the requests without the interceptor of the server
+	_, err = s.Todo.CreateTodo(context.Background(), &api.CreateTodoRequest{})
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	assert.Equal(s.T(), []string{"item"}, violatedFields(err))
+	_, err = s.Todo.UpdateTodo(context.Background(), &api.UpdateTodoRequest{})
+	assert.Equal(s.T(), []string{"item"}, violatedFields(err))
+	_, err = s.Todo.CreateTodoTemplate(context.Background(), &api.CreateTodoTemplateRequest{})
+	assert.Equal(s.T(), []string{"template"}, violatedFields(err))
 }
 
 func (s *TodoSuite) TestCreateTodos() {
@@ -144,7 +252,7 @@ func (s *TodoSuite) TestDeleteTodo() {
 	)
 	assert.Nil(s.T(), rget)
 	assert.NotNil(s.T(), err)
-	assert.Contains(s.T(), err.Error(), "Could not retrieve item from the database: pg: no rows in result set")
+	assert.Contains(s.T(), err.Error(), "Could not retrieve item: not found")
 }
 
 func (s *TodoSuite) TestUpdateTodo() {
@@ -332,3 +440,417 @@ func (s *TodoSuite) TestListTodo() {
 	assert.NotNil(s.T(), rlist.Items)
 	assert.Equal(s.T(), len(rlist.Items), 2)
 }
+
+func (s *TodoSuite) TestUpdateTodoStatus() {
+	s.Todo.Workflow = &workflow.Workflow{
+		Statuses: []string{"todo", "in_progress", "done"},
+		Initial:  "todo",
+		Terminal: []string{"done"},
+		Transitions: map[string][]string{
+			"todo":        {"in_progress"},
+			"in_progress": {"todo", "done"},
+		},
+	}
+	defer func() { s.Todo.Workflow = nil }()
+
+	item := &api.Todo{
+		Title:       "item_1",
+		Description: "item desc 1",
+	}
+	rcreate, err := s.Todo.CreateTodo(
+		context.Background(),
+		&api.CreateTodoRequest{
+			Item: item,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), item.Status, "todo")
+
+	id := rcreate.Id
+
+	// Skipping in_progress is not allowed
+	_, err = s.Todo.UpdateTodo(
+		context.Background(),
+		&api.UpdateTodoRequest{
+			Item: &api.Todo{Id: id, Title: "item_1", Status: "done"},
+		},
+	)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	for _, st := range []string{"in_progress", "done"} {
+		_, err = s.Todo.UpdateTodo(
+			context.Background(),
+			&api.UpdateTodoRequest{
+				Item: &api.Todo{Id: id, Title: "item_1", Status: st},
+			},
+		)
+		assert.Nil(s.T(), err)
+	}
+
+	// completed is derived from the terminal status
+	rget, err := s.Todo.GetTodo(
+		context.Background(),
+		&api.GetTodoRequest{
+			Id: id,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.Status, "done")
+	assert.True(s.T(), rget.Item.Completed)
+}
+
+func (s *TodoSuite) TestTimers() {
+	rcreate, err := s.Todo.CreateTodos(
+		context.Background(),
+		&api.CreateTodosRequest{
+			Items: []*api.Todo{
+				{Title: "item_1", Tags: []string{"billing"}},
+				{Title: "item_2", Tags: []string{"billing"}},
+			},
+		},
+	)
+	assert.Nil(s.T(), err)
+
+	rstart, err := s.Todo.StartTimer(
+		context.Background(),
+		&api.StartTimerRequest{
+			TodoId: rcreate.Ids[0],
+			UserId: "alice",
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), rstart.Entry)
+
+	// A user can only run one timer at a time
+	_, err = s.Todo.StartTimer(
+		context.Background(),
+		&api.StartTimerRequest{
+			TodoId: rcreate.Ids[1],
+			UserId: "alice",
+		},
+	)
+	assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	rstop, err := s.Todo.StopTimer(
+		context.Background(),
+		&api.StopTimerRequest{
+			UserId: "alice",
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rstart.Entry.Id, rstop.Entry.Id)
+	assert.NotNil(s.T(), rstop.Entry.StoppedAt)
+
+	// No running timer left
+	_, err = s.Todo.StopTimer(
+		context.Background(),
+		&api.StopTimerRequest{
+			UserId: "alice",
+		},
+	)
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+
+	rentries, err := s.Todo.ListTimeEntries(
+		context.Background(),
+		&api.ListTimeEntriesRequest{
+			TodoId: rcreate.Ids[0],
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rentries.Entries), 1)
+
+	rreport, err := s.Todo.TimeReport(
+		context.Background(),
+		&api.TimeReportRequest{
+			GroupBy: api.TimeReportRequest_TAG,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rreport.Rows), 1)
+	assert.Equal(s.T(), rreport.Rows[0].Key, "billing")
+}
+
+func (s *TodoSuite) TestCustomFields() {
+	// Custom fields are strings, a schema requiring other types is refused
+	_, err := s.Todo.RegisterCustomFieldSchema(
+		context.Background(),
+		&api.RegisterCustomFieldSchemaRequest{
+			List:   "support",
+			Schema: `{"type":"object","properties":{"ticket":{"type":"string"},"points":{"type":"integer"},"urgent":{"type":["boolean","null"]}}}`,
+		},
+	)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	assert.Equal(s.T(), []string{"schema.properties.points", "schema.properties.urgent"}, violatedFields(err))
+
+	_, err = s.Todo.RegisterCustomFieldSchema(
+		context.Background(),
+		&api.RegisterCustomFieldSchemaRequest{
+			List:   "support",
+			Schema: `{"type":"object","required":["ticket"],"properties":{"ticket":{"type":"string","pattern":"^T-[0-9]+$"}}}`,
+		},
+	)
+	assert.Nil(s.T(), err)
+
+	_, err = s.Todo.CreateTodo(
+		context.Background(),
+		&api.CreateTodoRequest{
+			Item: &api.Todo{
+				Title:        "item_1",
+				List:         "support",
+				CustomFields: map[string]string{"ticket": "42"},
+			},
+		},
+	)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	assert.Equal(s.T(), []string{"item.custom_fields.ticket"}, violatedFields(err))
+
+	// Each failing field is a violation, the missing ones included
+	_, err = s.Todo.CreateTodos(
+		context.Background(),
+		&api.CreateTodosRequest{
+			Items: []*api.Todo{
+				{Title: "item_0", List: "support", CustomFields: map[string]string{"ticket": "T-0"}},
+				{Title: "item_1", List: "support"},
+			},
+		},
+	)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	assert.Equal(s.T(), []string{"items[1].custom_fields.ticket"}, violatedFields(err))
+
+	for _, ticket := range []string{"T-2", "T-1"} {
+		_, err = s.Todo.CreateTodo(
+			context.Background(),
+			&api.CreateTodoRequest{
+				Item: &api.Todo{
+					Title:        "item_" + ticket,
+					List:         "support",
+					CustomFields: map[string]string{"ticket": ticket},
+				},
+			},
+		)
+		assert.Nil(s.T(), err)
+	}
+
+	rlist, err := s.Todo.ListTodo(
+		context.Background(),
+		&api.ListTodoRequest{
+			List:    "support",
+			OrderBy: "custom_fields.ticket",
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rlist.Items), 2)
+	assert.Equal(s.T(), rlist.Items[0].CustomFields["ticket"], "T-1")
+
+	rlist, err = s.Todo.ListTodo(
+		context.Background(),
+		&api.ListTodoRequest{
+			CustomFieldFilters: []string{"ticket=T-2"},
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rlist.Items), 1)
+}
+
+func (s *TodoSuite) TestDeleteTodos() {
+	rcreate, err := s.Todo.CreateTodos(
+		context.Background(),
+		&api.CreateTodosRequest{
+			Items: []*api.Todo{
+				{Title: "item_1", Completed: true},
+				{Title: "item_2", Completed: true},
+				{Title: "item_3"},
+				{Title: "item_4"},
+			},
+		},
+	)
+	assert.Nil(s.T(), err)
+
+	rdel, err := s.Todo.DeleteTodos(
+		context.Background(),
+		&api.DeleteTodosRequest{
+			Ids: rcreate.Ids[2:],
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rdel.Deleted, int32(2))
+
+	// Deleting by filter must be confirmed
+	_, err = s.Todo.DeleteTodosByFilter(
+		context.Background(),
+		&api.DeleteTodosByFilterRequest{
+			OnlyCompleted: true,
+		},
+	)
+	assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	rfilter, err := s.Todo.DeleteTodosByFilter(
+		context.Background(),
+		&api.DeleteTodosByFilterRequest{
+			OnlyCompleted: true,
+			DryRun:        true,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rfilter.Count, int32(2))
+
+	rfilter, err = s.Todo.DeleteTodosByFilter(
+		context.Background(),
+		&api.DeleteTodosByFilterRequest{
+			OnlyCompleted: true,
+			Confirm:       true,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rfilter.Count, int32(2))
+
+	rlist, err := s.Todo.ListTodo(
+		context.Background(),
+		&api.ListTodoRequest{},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rlist.Items), 0)
+}
+
+// violatedFields returns the fields of the BadRequest details of err.
+func violatedFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if bad, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range bad.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func (s *TodoSuite) TestUpdateTodosResults() {
+	rcreate, err := s.Todo.CreateTodos(
+		context.Background(),
+		&api.CreateTodosRequest{
+			Items: []*api.Todo{
+				{Title: "item_1"},
+			},
+		},
+	)
+	assert.Nil(s.T(), err)
+
+	items := []*api.Todo{
+		{Id: rcreate.Ids[0], Title: "item_1 update"},
+		{Id: "2b0fdb38-7ba2-4a5c-9d6d-3a0e0e3d5c4e", Title: "missing"},
+		{Id: rcreate.Ids[0], Title: "item_1 update", Status: "unknown"},
+	}
+
+	// Strict mode fails the whole batch
+	_, err = s.Todo.UpdateTodos(
+		context.Background(),
+		&api.UpdateTodosRequest{
+			Items:  items,
+			Strict: true,
+		},
+	)
+	assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	rget, err := s.Todo.GetTodo(
+		context.Background(),
+		&api.GetTodoRequest{
+			Id: rcreate.Ids[0],
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.Title, "item_1")
+
+	rupdate, err := s.Todo.UpdateTodos(
+		context.Background(),
+		&api.UpdateTodosRequest{
+			Items: items,
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rupdate.Results), 3)
+	assert.Equal(s.T(), rupdate.Results[0].Status, api.UpdateTodosResponse_Result_UPDATED)
+	assert.Equal(s.T(), rupdate.Results[1].Status, api.UpdateTodosResponse_Result_NOT_FOUND)
+	assert.Equal(s.T(), rupdate.Results[2].Status, api.UpdateTodosResponse_Result_INVALID)
+}
+
+func (s *TodoSuite) TestTemplates() {
+	rcreate, err := s.Todo.CreateTodoTemplate(
+		context.Background(),
+		&api.CreateTodoTemplateRequest{
+			Template: &api.TodoTemplate{
+				Name: "onboarding",
+				List: "hr",
+				Item: &api.TodoTemplate_Item{
+					Title:            "Onboarding",
+					DueOffsetSeconds: 7 * 24 * 3600,
+					Subtasks: []*api.TodoTemplate_Item{
+						{Title: "Laptop", Tags: []string{"it"}},
+						{Title: "Badge"},
+					},
+				},
+			},
+		},
+	)
+	assert.Nil(s.T(), err)
+
+	rinst, err := s.Todo.InstantiateTemplate(
+		context.Background(),
+		&api.InstantiateTemplateRequest{
+			Id:   rcreate.Id,
+			Base: &types.Timestamp{Seconds: 1000},
+		},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rinst.Ids), 3)
+
+	rget, err := s.Todo.GetTodo(context.Background(), &api.GetTodoRequest{Id: rinst.Ids[1]})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.ParentId, rinst.Ids[0])
+	assert.Equal(s.T(), rget.Item.List, "hr")
+
+	rclone, err := s.Todo.CloneTodo(context.Background(), &api.CloneTodoRequest{Id: rinst.Ids[0]})
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), rclone.Id, rinst.Ids[0])
+
+	rget, err = s.Todo.GetTodo(context.Background(), &api.GetTodoRequest{Id: rclone.Id})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.Title, "Onboarding")
+	assert.Equal(s.T(), rget.Item.DueAt.Seconds, int64(1000+7*24*3600))
+
+	rlist, err := s.Todo.ListTodo(context.Background(), &api.ListTodoRequest{List: "hr"})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), len(rlist.Items), 6)
+}
+
+func (s *TodoSuite) TestImportTodos() {
+	calendar := "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nUID:task-1@example.com\r\nSUMMARY:%s\r\nSTATUS:%s\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+
+	rimport, err := s.Todo.ImportTodos(
+		context.Background(),
+		&api.ImportTodosRequest{Calendar: fmt.Sprintf(calendar, "item_1", "NEEDS-ACTION")},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rimport.Created, int32(1))
+
+	rimport, err = s.Todo.ImportTodos(
+		context.Background(),
+		&api.ImportTodosRequest{Calendar: fmt.Sprintf(calendar, "item_1 update", "COMPLETED")},
+	)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rimport.Updated, int32(1))
+
+	rget, err := s.Todo.GetTodo(context.Background(), &api.GetTodoRequest{Id: rimport.Ids[0]})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.Title, "item_1 update")
+	assert.True(s.T(), rget.Item.Completed)
+	assert.Equal(s.T(), rget.Item.IcalUid, "task-1@example.com")
+
+	// Clones are served as other VTODOs
+	rclone, err := s.Todo.CloneTodo(context.Background(), &api.CloneTodoRequest{Id: rimport.Ids[0]})
+	assert.Nil(s.T(), err)
+	rget, err = s.Todo.GetTodo(context.Background(), &api.GetTodoRequest{Id: rclone.Id})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), rget.Item.IcalUid, "")
+}
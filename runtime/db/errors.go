@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/go-pg/pg"
+	"github.com/gofunct/gotasks/runtime/logging"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors of the connection pool of go-pg, which lives in an internal package.
+const (
+	errPoolClosed  = "pg: database is closed"
+	errPoolTimeout = "pg: connection pool timeout"
+)
+
+var log = logging.GrpcLog
+
+// dbError translates an error returned by the database into a gRPC status
+// prefixed by msg. Errors which already are a gRPC status are returned as is,
+// so that the errors returned inside of transactions are kept. Details of
+// unexpected errors are logged and hidden from clients.
+func dbError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case err == pg.ErrNoRows, err == storage.ErrNotFound:
+		return grpc.Errorf(codes.NotFound, "%s: not found", msg)
+	case err == context.Canceled:
+		return grpc.Errorf(codes.Canceled, "%s: canceled", msg)
+	case err == context.DeadlineExceeded:
+		return grpc.Errorf(codes.DeadlineExceeded, "%s: deadline exceeded", msg)
+	case err == storage.ErrAlreadyExists, isUniqueViolation(err):
+		return grpc.Errorf(codes.AlreadyExists, "%s: already exists", msg)
+	}
+	if pgErr, ok := err.(pg.Error); ok {
+		code := pgErr.Field('C')
+		switch {
+		// Data exceptions, such as invalid input syntax for a UUID, whose
+		// messages are logged rather than sent to the clients
+		case strings.HasPrefix(code, "22"):
+			log.Zap.Debug(msg, zap.Error(err))
+			return grpc.Errorf(codes.InvalidArgument, "%s: invalid argument", msg)
+		// Statement canceled, either by a timeout or by the client
+		case code == "57014":
+			return grpc.Errorf(codes.DeadlineExceeded, "%s: deadline exceeded", msg)
+		// Connection exceptions, insufficient resources and server shutdowns
+		case strings.HasPrefix(code, "08"), strings.HasPrefix(code, "53"), strings.HasPrefix(code, "57P"):
+			return unavailable(err, msg)
+		}
+	} else if isConnError(err) {
+		return unavailable(err, msg)
+	}
+	log.Zap.Error(msg, zap.Error(err))
+	return grpc.Errorf(codes.Internal, "%s: internal error", msg)
+}
+
+func unavailable(err error, msg string) error {
+	log.Zap.Warn(msg, zap.Error(err))
+	return grpc.Errorf(codes.Unavailable, "%s: database unavailable", msg)
+}
+
+func isConnError(err error) bool {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	return err.Error() == errPoolClosed || err.Error() == errPoolTimeout
+}
+
+func isUniqueViolation(err error) bool {
+	pgErr, ok := err.(pg.Error)
+	return ok && pgErr.Field('C') == "23505"
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/go-pg/pg"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type pgError string
+
+func (e pgError) Field(k byte) string {
+	if k == 'C' {
+		return string(e)
+	}
+	return ""
+}
+func (e pgError) IntegrityViolation() bool { return false }
+func (e pgError) Error() string            { return string(e) }
+
+func TestDBError(t *testing.T) {
+	for err, code := range map[error]codes.Code{
+		pg.ErrNoRows:                     codes.NotFound,
+		context.Canceled:                 codes.Canceled,
+		context.DeadlineExceeded:         codes.DeadlineExceeded,
+		pgError("23505"):                 codes.AlreadyExists,
+		pgError("22P02"):                 codes.InvalidArgument,
+		pgError("08006"):                 codes.Unavailable,
+		io.EOF:                           codes.Unavailable,
+		errors.New(errPoolTimeout):       codes.Unavailable,
+		errors.New("pg: Model(nil)"):     codes.Internal,
+		status.Error(codes.NotFound, ""): codes.NotFound,
+	} {
+		assert.Equal(t, code, status.Code(dbError(err, "Could not")), "%v", err)
+	}
+	assert.Equal(t, "Could not: internal error", status.Convert(dbError(errors.New("secret"), "Could not")).Message())
+	assert.Equal(t, "Could not: invalid argument", status.Convert(dbError(pgError("22P02"), "Could not")).Message())
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/ical"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/gogo/protobuf/types"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// icalNamespace derives the IDs of the items imported with a UID which is not a UUID.
+var icalNamespace = uuid.Must(uuid.FromString("7b3c1c52-6d8e-4f0a-9a55-1f3e5b2d8c41"))
+
+// ImportTodos creates or updates the todo items described by the VTODO
+// components of an iCalendar document in a single transaction. Items are
+// matched by UID, UIDs which are not UUIDs are mapped to a UUID derived
+// from them so that importing a document again updates the same items, and
+// kept as the iCalendar UID of the items to be served back in the feed.
+func (s Store) ImportTodos(ctx context.Context, req *todo.ImportTodosRequest) (*todo.ImportTodosResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	items, err := ical.Parse(strings.NewReader(req.Calendar))
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not parse calendar: %s", err)
+	}
+	if len(items) > validation.MaxBatchSize {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not import items: more than %d items", validation.MaxBatchSize)
+	}
+	var invalid validation.Violations
+	ids := make([]string, 0, len(items))
+	for i, item := range items {
+		if _, err := uuid.FromString(item.Id); err != nil {
+			item.IcalUid, item.Id = item.Id, uuid.NewV5(icalNamespace, item.Id).String()
+		}
+		ids = append(ids, item.Id)
+		invalid = append(invalid, validation.Todo(fmt.Sprintf("calendar.vtodo[%d].", i), item, false)...)
+	}
+	if err := invalid.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &todo.ImportTodosResponse{Ids: ids}
+	now := types.TimestampNow()
+	err = s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		current, err := tx.LockTodos(ctx, ids...)
+		if err != nil {
+			return dbError(err, "Could not retrieve items")
+		}
+		byID := make(map[string]*todo.Todo, len(current))
+		for _, item := range current {
+			byID[item.Id] = item
+		}
+		for _, item := range items {
+			cur, ok := byID[item.Id]
+			if !ok {
+				if err := s.initStatus(item); err != nil {
+					return err
+				}
+				if item.CreatedAt == nil {
+					item.CreatedAt = now
+				}
+				if err := tx.CreateTodos(ctx, item); err != nil {
+					return dbError(err, "Could not insert item")
+				}
+				// Later components with the same UID update this one
+				byID[item.Id] = item
+				res.Created++
+				continue
+			}
+			if err := s.transition(cur, item); err != nil {
+				return err
+			}
+			if item.UpdatedAt == nil {
+				item.UpdatedAt = now
+			}
+			// Calendars know nothing about lists and custom fields
+			item.List, item.CustomFields = cur.List, cur.CustomFields
+			if err := tx.UpdateTodos(ctx, item); err != nil {
+				return dbError(err, "Could not update item")
+			}
+			cur.Status, cur.Completed = item.Status, item.Completed
+			res.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err, "Could not import items")
+	}
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/quickadd"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// QuickAddTodo creates a todo item from a sentence and returns the parts of
+// the sentence it recognized. Nothing is created for a dry run.
+func (s Store) QuickAddTodo(ctx context.Context, req *todo.QuickAddTodoRequest) (*todo.QuickAddTodoResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	loc := time.UTC
+	if req.TimeZone != "" {
+		l, err := time.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Unknown time zone %q", req.TimeZone)
+		}
+		loc = l
+	}
+	item, tokens := quickadd.Parser{Now: s.Clock}.Parse(req.Text, loc)
+	res := &todo.QuickAddTodoResponse{Item: item}
+	for _, t := range tokens {
+		res.Tokens = append(res.Tokens, &todo.QuickAddTodoResponse_Token{Text: t.Text, Field: t.Field})
+	}
+	if err := validation.Todo("item.", item, false).Err(); err != nil {
+		return nil, err
+	}
+	if err := s.initStatus(item); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return res, nil
+	}
+
+	item.Id = uuid.NewV4().String()
+	err := s.Repo.CreateTodos(ctx, item)
+	if err != nil {
+		return nil, dbError(err, "Could not insert item")
+	}
+	res.Id = item.Id
+	return res, nil
+}
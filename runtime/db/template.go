@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/gogo/protobuf/types"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// CloneTodo deep-copies a todo item and its subtasks under new IDs.
+// The copy keeps the parent of the original item, time tracked
+// on the original items and their iCalendar UIDs are not copied.
+func (s Store) CloneTodo(ctx context.Context, req *todo.CloneTodoRequest) (*todo.CloneTodoResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	var id string
+	err := s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		root, err := tx.GetTodo(ctx, req.Id)
+		if err != nil {
+			return dbError(err, "Could not retrieve item")
+		}
+		now := types.TimestampNow()
+		ids := map[string]string{}
+		level := []*todo.Todo{root}
+		for len(level) > 0 {
+			originals := make([]string, 0, len(level))
+			for _, item := range level {
+				originals = append(originals, item.Id)
+				ids[item.Id] = uuid.NewV4().String()
+				item.Id = ids[item.Id]
+				if parent, ok := ids[item.ParentId]; ok {
+					item.ParentId = parent
+				}
+				item.TrackedSeconds = 0
+				item.IcalUid = ""
+				item.CreatedAt = now
+				item.UpdatedAt = nil
+			}
+			if err := tx.CreateTodos(ctx, level...); err != nil {
+				return dbError(err, "Could not insert items")
+			}
+			subtasks, err := tx.ListTodos(ctx, storage.Filter{ParentIDs: originals})
+			if err != nil {
+				return dbError(err, "Could not retrieve subtasks")
+			}
+			level = subtasks
+		}
+		id = root.Id
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err, "Could not clone item")
+	}
+	return &todo.CloneTodoResponse{Id: id}, nil
+}
+
+// CreateTodoTemplate stores a template of todo items
+func (s Store) CreateTodoTemplate(ctx context.Context, req *todo.CreateTodoTemplateRequest) (*todo.CreateTodoTemplateResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	req.Template.Id = uuid.NewV4().String()
+	req.Template.CreatedAt = types.TimestampNow()
+	err := s.Repo.CreateTemplate(ctx, req.Template)
+	if err != nil {
+		return nil, dbError(err, "Could not insert template")
+	}
+	return &todo.CreateTodoTemplateResponse{Id: req.Template.Id}, nil
+}
+
+// GetTodoTemplate retrieves a template from its ID
+func (s Store) GetTodoTemplate(ctx context.Context, req *todo.GetTodoTemplateRequest) (*todo.GetTodoTemplateResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	template, err := s.Repo.GetTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, dbError(err, "Could not retrieve template")
+	}
+	return &todo.GetTodoTemplateResponse{Template: template}, nil
+}
+
+// ListTodoTemplates retrieves every template sorted by name
+func (s Store) ListTodoTemplates(ctx context.Context, req *todo.ListTodoTemplatesRequest) (*todo.ListTodoTemplatesResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	templates, err := s.Repo.ListTemplates(ctx)
+	if err != nil {
+		return nil, dbError(err, "Could not list templates")
+	}
+	return &todo.ListTodoTemplatesResponse{Templates: templates}, nil
+}
+
+// DeleteTodoTemplate deletes a template given an ID, the todos
+// instantiated from it are kept
+func (s Store) DeleteTodoTemplate(ctx context.Context, req *todo.DeleteTodoTemplateRequest) (*todo.DeleteTodoTemplateResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	err := s.Repo.DeleteTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, dbError(err, "Could not delete template")
+	}
+	return &todo.DeleteTodoTemplateResponse{}, nil
+}
+
+// InstantiateTemplate creates the todo items described by a template in a
+// single transaction. Due dates are computed from the base date of the request.
+func (s Store) InstantiateTemplate(ctx context.Context, req *todo.InstantiateTemplateRequest) (*todo.InstantiateTemplateResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	template, err := s.Repo.GetTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, dbError(err, "Could not retrieve template")
+	}
+	if template.Item == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Could not instantiate template: template has no item")
+	}
+	base := time.Now()
+	if req.Base != nil {
+		base = timestamp(req.Base)
+	}
+
+	var items []*todo.Todo
+	var add func(t *todo.TodoTemplate_Item, parent string) error
+	add = func(t *todo.TodoTemplate_Item, parent string) error {
+		item := &todo.Todo{
+			Id:           uuid.NewV4().String(),
+			Title:        t.Title,
+			Description:  t.Description,
+			Tags:         t.Tags,
+			List:         template.List,
+			CustomFields: t.CustomFields,
+			ParentId:     parent,
+		}
+		if t.DueOffsetSeconds != 0 {
+			due, err := types.TimestampProto(base.Add(time.Duration(t.DueOffsetSeconds) * time.Second))
+			if err != nil {
+				return grpc.Errorf(codes.InvalidArgument, "Could not instantiate template: %s", err)
+			}
+			item.DueAt = due
+		}
+		if err := s.initStatus(item); err != nil {
+			return err
+		}
+		items = append(items, item)
+		for _, subtask := range t.Subtasks {
+			if err := add(subtask, item.Id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(template.Item, ""); err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomFields(ctx, itemsPath, items...); err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.CreateTodos(ctx, items...)
+	if err != nil {
+		return nil, dbError(err, "Could not insert items")
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return &todo.InstantiateTemplateResponse{Ids: ids}, nil
+}
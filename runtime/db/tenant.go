@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+
+	"github.com/gofunct/gotasks/runtime/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// TenantMetadata is the metadata key of the tenant of a request, set by the
+// authenticating proxy in front of the service. The REST gateway forwards it
+// from the Grpc-Metadata-X-Tenant-Id header.
+const TenantMetadata = "x-tenant-id"
+
+// TenantUnaryServerInterceptor scopes the records of each request of the
+// Store to the tenant of its metadata, see storage.WithTenant. Requests
+// without a tenant are rejected with Unauthenticated when required.
+func TenantUnaryServerInterceptor(required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := info.Server.(*Store); !ok {
+			return handler(ctx, req)
+		}
+		var tenant string
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get(TenantMetadata); len(values) > 0 {
+			tenant = values[0]
+		}
+		if tenant == "" {
+			if required {
+				return nil, grpc.Errorf(codes.Unauthenticated, "Could not identify the tenant: missing %s metadata", TenantMetadata)
+			}
+			return handler(ctx, req)
+		}
+		return handler(storage.WithTenant(ctx, tenant), req)
+	}
+}
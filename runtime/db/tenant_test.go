@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-pg/pg"
+	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/postgres"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantUnaryServerInterceptor(t *testing.T) {
+	var tenant string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		tenant = storage.Tenant(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{Server: &Store{}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TenantMetadata, "acme"))
+
+	_, err := TenantUnaryServerInterceptor(true)(ctx, nil, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "acme", tenant)
+
+	_, err = TenantUnaryServerInterceptor(true)(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = TenantUnaryServerInterceptor(false)(context.Background(), nil, info, handler)
+	assert.Nil(t, err)
+
+	// The other services, such as the health service, are not scoped
+	_, err = TenantUnaryServerInterceptor(true)(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, err)
+}
+
+// TestTenantIsolation checks that the row level security policies keep the
+// todos, time entries and templates of the tenants apart, whatever the
+// filters of the queries.
+func TestTenantIsolation(t *testing.T) {
+	db := pg.Connect(&pg.Options{
+		User:                  "postgres",
+		Database:              "todo",
+		Addr:                  "localhost:5432",
+		RetryStatementTimeout: true,
+		MaxRetries:            4,
+		MinRetryBackoff:       250 * time.Millisecond,
+	})
+	defer db.Close()
+	ctx := context.Background()
+	owner := postgres.New(db)
+	owner.Migrator().Down(ctx, len(postgres.Migrations))
+	if _, err := owner.Migrator().Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer owner.Migrator().Down(ctx, len(postgres.Migrations))
+	for _, stmt := range []string{
+		`DO $$ BEGIN
+			IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'gotasks_tenant') THEN
+				CREATE ROLE gotasks_tenant;
+			END IF;
+		END $$`,
+		"GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO gotasks_tenant",
+		"GRANT USAGE ON ALL SEQUENCES IN SCHEMA public TO gotasks_tenant",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo := owner.WithTenantRole("gotasks_tenant")
+	s := &Store{Repo: repo}
+	a := storage.WithTenant(ctx, "a")
+	b := storage.WithTenant(ctx, "b")
+
+	rcreate, err := s.CreateTodo(a, &api.CreateTodoRequest{Item: &api.Todo{Title: "a"}})
+	assert.Nil(t, err)
+	_, err = s.CreateTodo(b, &api.CreateTodoRequest{Item: &api.Todo{Title: "b"}})
+	assert.Nil(t, err)
+
+	_, err = s.GetTodo(b, &api.GetTodoRequest{Id: rcreate.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	rlist, err := s.ListTodo(b, &api.ListTodoRequest{})
+	assert.Nil(t, err)
+	if assert.Len(t, rlist.Items, 1) {
+		assert.Equal(t, "b", rlist.Items[0].Title)
+	}
+
+	// Queries without a filter only see the todos of their tenant
+	deleted, err := repo.DeleteTodos(b, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+	count, err := repo.CountTodos(a, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	// Queries without a tenant see nothing and cannot write
+	count, err = repo.CountTodos(ctx, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.NotNil(t, repo.CreateTodos(ctx, &api.Todo{Id: "none"}))
+	_, err = s.UpdateTodo(b, &api.UpdateTodoRequest{Item: &api.Todo{Id: rcreate.Id, Title: "stolen"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The owner of the table bypasses the policies
+	count, err = owner.CountTodos(ctx, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	// The same user runs a timer in each tenant, and only sees the time
+	// entries of its tenant
+	rcreate2, err := s.CreateTodo(b, &api.CreateTodoRequest{Item: &api.Todo{Title: "b2"}})
+	assert.Nil(t, err)
+	_, err = s.StartTimer(a, &api.StartTimerRequest{TodoId: rcreate.Id, UserId: "u"})
+	assert.Nil(t, err)
+	_, err = s.StartTimer(b, &api.StartTimerRequest{TodoId: rcreate2.Id, UserId: "u"})
+	assert.Nil(t, err)
+	rentries, err := s.ListTimeEntries(b, &api.ListTimeEntriesRequest{TodoId: rcreate.Id})
+	assert.Nil(t, err)
+	assert.Empty(t, rentries.Entries)
+	rstop, err := s.StopTimer(b, &api.StopTimerRequest{UserId: "u"})
+	assert.Nil(t, err)
+	assert.Equal(t, rcreate2.Id, rstop.Entry.TodoId)
+	rreport, err := s.TimeReport(b, &api.TimeReportRequest{
+		From: &types.Timestamp{Seconds: time.Now().Add(-time.Hour).Unix()},
+	})
+	assert.Nil(t, err)
+	if assert.Len(t, rreport.Rows, 1) {
+		assert.Equal(t, rcreate2.Id, rreport.Rows[0].Key)
+	}
+
+	// The templates of a tenant are not seen by the others
+	rtemplate, err := s.CreateTodoTemplate(a, &api.CreateTodoTemplateRequest{
+		Template: &api.TodoTemplate{Name: "a", Item: &api.TodoTemplate_Item{Title: "a"}},
+	})
+	assert.Nil(t, err)
+	rtemplates, err := s.ListTodoTemplates(b, &api.ListTodoTemplatesRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, rtemplates.Templates)
+	_, err = s.GetTodoTemplate(b, &api.GetTodoTemplateRequest{Id: rtemplate.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	rtemplates, err = s.ListTodoTemplates(a, &api.ListTodoTemplatesRequest{})
+	assert.Nil(t, err)
+	assert.Len(t, rtemplates.Templates, 1)
+}
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/gogo/protobuf/types"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// StartTimer starts a timer on a todo item for a user
+func (s Store) StartTimer(ctx context.Context, req *todo.StartTimerRequest) (*todo.StartTimerResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not start timer: missing user id")
+	}
+	entry := &todo.TimeEntry{
+		Id:        uuid.NewV4().String(),
+		TodoId:    req.TodoId,
+		UserId:    req.UserId,
+		StartedAt: types.TimestampNow(),
+	}
+	err := s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		_, err := tx.GetTodo(ctx, req.TodoId)
+		if err == storage.ErrNotFound {
+			return grpc.Errorf(codes.NotFound, "Could not start timer: item not found")
+		}
+		if err != nil {
+			return dbError(err, "Could not start timer")
+		}
+		err = tx.CreateTimeEntry(ctx, entry)
+		if err == storage.ErrAlreadyExists {
+			return grpc.Errorf(codes.FailedPrecondition, "Could not start timer: user %s already has a running timer", req.UserId)
+		}
+		if err != nil {
+			return dbError(err, "Could not insert time entry")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err, "Could not start timer")
+	}
+	return &todo.StartTimerResponse{Entry: entry}, nil
+}
+
+// StopTimer stops the running timer of a user and adds
+// its duration to the tracked time of the todo item.
+func (s Store) StopTimer(ctx context.Context, req *todo.StopTimerRequest) (*todo.StopTimerResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	var entry *todo.TimeEntry
+	err := s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		var err error
+		entry, err = tx.RunningTimeEntry(ctx, req.UserId)
+		if err == storage.ErrNotFound {
+			return grpc.Errorf(codes.NotFound, "Could not stop timer: no running timer for user %s", req.UserId)
+		}
+		if err != nil {
+			return dbError(err, "Could not retrieve time entry")
+		}
+		entry.StoppedAt = types.TimestampNow()
+		entry.DurationSeconds = int64(timestamp(entry.StoppedAt).Sub(timestamp(entry.StartedAt)) / time.Second)
+		err = tx.StopTimeEntry(ctx, entry)
+		if err != nil {
+			return dbError(err, "Could not update time entry")
+		}
+		err = tx.AddTrackedSeconds(ctx, entry.TodoId, entry.DurationSeconds)
+		if err != nil {
+			return dbError(err, "Could not update item")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err, "Could not stop timer")
+	}
+	return &todo.StopTimerResponse{Entry: entry}, nil
+}
+
+// ListTimeEntries retrieves the time entries of a todo item
+func (s Store) ListTimeEntries(ctx context.Context, req *todo.ListTimeEntriesRequest) (*todo.ListTimeEntriesResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	entries, err := s.Repo.ListTimeEntries(ctx, req.TodoId)
+	if err != nil {
+		return nil, dbError(err, "Could not list time entries")
+	}
+	return &todo.ListTimeEntriesResponse{Entries: entries}, nil
+}
+
+// TimeReport sums the time tracked between two dates per todo item, tag or list.
+// Entries overlapping the range only count for the part inside of it and
+// running timers count up to now.
+func (s Store) TimeReport(ctx context.Context, req *todo.TimeReportRequest) (*todo.TimeReportResponse, error) {
+	if err := validation.Request(req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	from, to := timestamp(req.From), now
+	if req.To != nil {
+		to = timestamp(req.To)
+	}
+	if !from.Before(to) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Could not build report: from must be before to")
+	}
+
+	entries, err := s.Repo.TimeEntriesBetween(ctx, from, to)
+	if err != nil {
+		return nil, dbError(err, "Could not list time entries")
+	}
+	if len(entries) == 0 {
+		return &todo.TimeReportResponse{}, nil
+	}
+
+	keys := func(e *todo.TimeEntry) []string { return []string{e.TodoId} }
+	if req.GroupBy != todo.TimeReportRequest_TODO {
+		ids := make([]string, 0, len(entries))
+		for _, e := range entries {
+			ids = append(ids, e.TodoId)
+		}
+		items, err := s.Repo.ListTodos(ctx, storage.Filter{IDs: ids})
+		if err != nil {
+			return nil, dbError(err, "Could not list items")
+		}
+		byID := make(map[string]*todo.Todo, len(items))
+		for _, item := range items {
+			byID[item.Id] = item
+		}
+		keys = func(e *todo.TimeEntry) []string {
+			item, ok := byID[e.TodoId]
+			switch {
+			case !ok:
+				return nil
+			case req.GroupBy == todo.TimeReportRequest_TAG:
+				return item.Tags
+			case item.List != "":
+				return []string{item.List}
+			default:
+				return nil
+			}
+		}
+	}
+
+	var rows []*todo.TimeReportResponse_Row
+	index := map[string]*todo.TimeReportResponse_Row{}
+	for _, e := range entries {
+		start, stop := timestamp(e.StartedAt), now
+		if e.StoppedAt != nil {
+			stop = timestamp(e.StoppedAt)
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if stop.After(to) {
+			stop = to
+		}
+		if !start.Before(stop) {
+			continue
+		}
+		for _, key := range keys(e) {
+			row, ok := index[key]
+			if !ok {
+				row = &todo.TimeReportResponse_Row{Key: key}
+				index[key] = row
+				rows = append(rows, row)
+			}
+			row.Seconds += int64(stop.Sub(start) / time.Second)
+		}
+	}
+	return &todo.TimeReportResponse{Rows: rows}, nil
+}
+
+func timestamp(ts *types.Timestamp) time.Time {
+	if ts == nil {
+		return time.Unix(0, 0)
+	}
+	return time.Unix(ts.Seconds, int64(ts.Nanos))
+}
@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+type errorBody struct {
+	Error           string           `json:"error"`
+	Code            int32            `json:"code"`
+	FieldViolations []fieldViolation `json:"field_violations,omitempty"`
+}
+
+// HTTPError renders the errors carrying a BadRequest detail as a JSON body
+// listing the field violations, other errors use the default gateway format.
+func HTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	body := errorBody{Error: s.Message(), Code: int32(s.Code())}
+	for _, d := range s.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				body.FieldViolations = append(body.FieldViolations, fieldViolation{Field: v.Field, Description: v.Description})
+			}
+		}
+	}
+	if len(body.FieldViolations) == 0 {
+		runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Zap.Debug("Failed to write error response", zap.Error(err))
+	}
+}
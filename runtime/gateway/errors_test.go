@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPError(t *testing.T) {
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+	r := httptest.NewRequest(http.MethodPost, "/v1/todo", nil)
+
+	var v validation.Violations
+	v.Add("item.title", "is required")
+	v.Add("item.tags[0]", "must not be longer than %d characters", validation.MaxTagLength)
+	w := httptest.NewRecorder()
+	HTTPError(context.Background(), mux, marshaler, w, r, v.Err())
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"error": "Invalid request: item.title: is required (and 1 more)",
+		"code": 3,
+		"field_violations": [
+			{"field": "item.title", "description": "is required"},
+			{"field": "item.tags[0]", "description": "must not be longer than 50 characters"}
+		]
+	}`, w.Body.String())
+
+	// Errors without field violations keep the format of the gateway
+	w = httptest.NewRecorder()
+	HTTPError(context.Background(), mux, marshaler, w, r, status.Error(codes.NotFound, "Could not retrieve item: not found"))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var body map[string]interface{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "Could not retrieve item: not found", body["error"])
+	assert.NotContains(t, body, "field_violations")
+}
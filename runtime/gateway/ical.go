@@ -0,0 +1,58 @@
+package gateway
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/ical"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+)
+
+// ICalHandler serves the todo items matching the ListTodo query parameters
+// as an iCalendar feed on GET, and imports an iCalendar document on POST,
+// replying with the IDs of the imported items. The headers of the request
+// are forwarded as metadata like the ones of mux.
+func ICalHandler(mux *runtime.ServeMux, client api.TodoServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			var req api.ListTodoRequest
+			if err := runtime.PopulateQueryParameters(&req, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			res, err := client.ListTodo(ctx, &req)
+			if err != nil {
+				textError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+			ical.Format(w, res.Items)
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, validation.MaxCalendarSize))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			res, err := client.ImportTodos(ctx, &api.ImportTodosRequest{Calendar: string(body)})
+			if err != nil {
+				textError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.Write([]byte(strings.Join(res.Ids, "\n") + "\n"))
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
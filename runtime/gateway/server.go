@@ -32,6 +32,7 @@ func Serve() func(cmd *cobra.Command, args []string) {
 		if err != nil {
 			log.Fatal("failed to dial grpc backend", zap.Error(err))
 		}
+		runtime.HTTPError = HTTPError
 		gwmux := runtime.NewServeMux()
 		mux := NewMux(gwmux)
 
@@ -39,6 +40,9 @@ func Serve() func(cmd *cobra.Command, args []string) {
 		if err != nil {
 			panic("Cannot serve http api")
 		}
+		client := api.NewTodoServiceClient(conn)
+		mux.Handle("/v1/todo.txt", TodoTxtHandler(gwmux, client))
+		mux.Handle("/v1/todo.ics", ICalHandler(gwmux, client))
 
 		if len(viper.GetStringSlice("domains")) > 0 {
 
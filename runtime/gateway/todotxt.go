@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/todotxt"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/status"
+)
+
+// TodoTxtHandler exports every todo item in the todo.txt format on GET
+// and imports a todo.txt file on POST, replying with the created IDs. The
+// headers of the request are forwarded as metadata like the ones of mux.
+func TodoTxtHandler(mux *runtime.ServeMux, client api.TodoServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			if err := todotxt.Export(ctx, client, w); err != nil {
+				textError(w, err)
+			}
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, validation.MaxTodoTxtSize))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			ids, err := todotxt.Import(ctx, client, bytes.NewReader(body))
+			if err != nil {
+				textError(w, err)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.Write([]byte(strings.Join(ids, "\n") + "\n"))
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func textError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/db"
+	"github.com/gofunct/gotasks/runtime/validation"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// client records the requests of the handlers, the methods it does not
+// implement panic.
+type client struct {
+	api.TodoServiceClient
+	created []*api.Todo
+	md      metadata.MD
+}
+
+func (c *client) CreateTodos(ctx context.Context, req *api.CreateTodosRequest, opts ...grpc.CallOption) (*api.CreateTodosResponse, error) {
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	c.created = append(c.created, req.Items...)
+	ids := make([]string, len(req.Items))
+	for i := range ids {
+		ids[i] = "id"
+	}
+	return &api.CreateTodosResponse{Ids: ids}, nil
+}
+
+func (c *client) ImportTodos(ctx context.Context, req *api.ImportTodosRequest, opts ...grpc.CallOption) (*api.ImportTodosResponse, error) {
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	return &api.ImportTodosResponse{Ids: []string{"id"}, Created: 1}, nil
+}
+
+func TestTodoTxtHandlerImport(t *testing.T) {
+	c := &client{}
+	h := TodoTxtHandler(runtime.NewServeMux(), c)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/todo.txt", strings.NewReader("(A) Call Mom\nx Pay the bills\n")))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "id\nid\n", w.Body.String())
+	assert.Len(t, c.created, 2)
+
+	c.created = nil
+	w = httptest.NewRecorder()
+	body := strings.Repeat("Call Mom\n", validation.MaxTodoTxtSize/9+1)
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/todo.txt", strings.NewReader(body)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Empty(t, c.created)
+}
+
+func TestHandlersForwardTenant(t *testing.T) {
+	c := &client{}
+	mux := runtime.NewServeMux()
+	for _, tc := range []struct {
+		handler http.Handler
+		path    string
+		body    string
+	}{
+		{TodoTxtHandler(mux, c), "/v1/todo.txt", "Call Mom\n"},
+		{ICalHandler(mux, c), "/v1/todo.ics", "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"},
+	} {
+		c.md = nil
+		req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+		req.Header.Set("Grpc-Metadata-X-Tenant-Id", "acme")
+		w := httptest.NewRecorder()
+		tc.handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, tc.path)
+		assert.Equal(t, []string{"acme"}, c.md.Get(db.TenantMetadata), tc.path)
+	}
+}
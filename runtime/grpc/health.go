@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofunct/gotasks/runtime/healthcheck"
+	"github.com/gofunct/gotasks/runtime/storage"
+	vi "github.com/gofunct/gotasks/runtime/viper"
+	"github.com/uber/jaeger-client-go"
+	jconfig "github.com/uber/jaeger-client-go/config"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+)
+
+// todoService is the name of the TodoService in the health service.
+const todoService = "todo.v1.TodoService"
+
+// Default health check settings.
+const (
+	DefaultHealthCheckInterval    = 5 * time.Second
+	DefaultHealthCheckTimeout     = time.Second
+	DefaultHealthFailureThreshold = 3
+	DefaultHealthSuccessThreshold = 2
+)
+
+// Pinger is implemented by the storage backends depending on a database.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// NewHealthChecker returns the checker setting the status of the
+// TodoService in hs from the health of its database, reported as
+// database, and of the agent of the tracer, reported as tracer_agent.
+// Traces are not required to serve requests, so a failing agent does not
+// make the TodoService not serving.
+func NewHealthChecker(hs *health.Server, repo storage.Repository) *healthcheck.Checker {
+	var checks []healthcheck.Check
+	if p, ok := repo.(Pinger); ok {
+		checks = append(checks, healthcheck.Check{Name: "database", Probe: p.Ping, Critical: true})
+	}
+	if probe := tracerAgentProbe(); probe != nil {
+		checks = append(checks, healthcheck.Check{Name: "tracer_agent", Probe: probe})
+	}
+	return &healthcheck.Checker{
+		Server:           hs,
+		Services:         []string{"", todoService},
+		Checks:           checks,
+		Interval:         durationOr(vi.VDuration("health_check_interval"), DefaultHealthCheckInterval),
+		Timeout:          durationOr(vi.VDuration("health_check_timeout"), DefaultHealthCheckTimeout),
+		FailureThreshold: intOr(vi.VInt("health_failure_threshold"), DefaultHealthFailureThreshold),
+		SuccessThreshold: intOr(vi.VInt("health_success_threshold"), DefaultHealthSuccessThreshold),
+		OnChange: func(name string, err error) {
+			if err != nil {
+				log.Zap.Error("health check failing", zap.String("check", name), zap.Error(err))
+			} else {
+				log.Zap.Info("health check recovered", zap.String("check", name))
+			}
+		},
+	}
+}
+
+// tracerAgentProbe returns a probe of the sampling server of the jaeger
+// agent, or nil when the tracer does not poll the agent for its sampling
+// strategy.
+func tracerAgentProbe() func(ctx context.Context) error {
+	cfg, err := jconfig.FromEnv()
+	if err != nil || cfg.Reporter.CollectorEndpoint != "" {
+		return nil
+	}
+	if cfg.Sampler.Type != "" && strings.ToLower(cfg.Sampler.Type) != jaeger.SamplerTypeRemote {
+		return nil
+	}
+	u := cfg.Sampler.SamplingServerURL
+	if u == "" {
+		u = "http://localhost:5778/sampling"
+	}
+	u += "?service=" + url.QueryEscape(tracerServiceName)
+	return func(ctx context.Context) error {
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("sampling server answered %s", res.Status)
+		}
+		return nil
+	}
+}
+
+func durationOr(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
+
+func intOr(n, def int) int {
+	if n <= 0 {
+		return def
+	}
+	return n
+}
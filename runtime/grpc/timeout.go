@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	vi "github.com/gofunct/gotasks/runtime/viper"
+	"google.golang.org/grpc"
+)
+
+// DefaultRequestTimeout is the deadline of the unary requests whose client
+// sets none.
+const DefaultRequestTimeout = 30 * time.Second
+
+// TimeoutUnaryServerInterceptor bounds the unary requests without a deadline
+// by timeout. The deadline of the request reaches the queries of the
+// storage through its context, postgres cancels them once it passed.
+func TimeoutUnaryServerInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// requestTimeout returns the configured default deadline of the requests.
+func requestTimeout() time.Duration {
+	return durationOr(vi.VDuration("grpc_default_timeout"), DefaultRequestTimeout)
+}
@@ -7,13 +7,16 @@ import (
 	"io"
 )
 
+// tracerServiceName is the name of the service in the traces.
+const tracerServiceName = "goservice_grpc"
+
 func Trace(log *zapjaeger.Logger) (opentracing.Tracer, io.Closer, error) {
 	var err error
 	cfg, err := jconfig.FromEnv()
 	if err != nil {
 		return nil, nil, err
 	}
-	cfg.ServiceName = "goservice_grpc"
+	cfg.ServiceName = tracerServiceName
 	cfg.RPCMetrics = true
 	tracer, closer, err := cfg.NewTracer(jconfig.Logger(log))
 	if err != nil {
@@ -0,0 +1,127 @@
+// Package healthcheck periodically probes the dependencies of the service,
+// such as its database, and reports their status to the gRPC health service.
+package healthcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check probes a dependency. Its status is reported under its name.
+type Check struct {
+	Name  string
+	Probe func(ctx context.Context) error
+	// Critical checks make the services of the checker not serving while
+	// they fail, the service does not work without the dependency.
+	Critical bool
+}
+
+// Checker runs its checks every interval. A check fails after
+// FailureThreshold consecutive failed probes and recovers after
+// SuccessThreshold consecutive successful ones, so that a flapping
+// dependency does not flip the status at every probe.
+type Checker struct {
+	Server *health.Server
+	// Services are set NOT_SERVING while a critical check fails.
+	Services         []string
+	Checks           []Check
+	Interval         time.Duration
+	Timeout          time.Duration
+	FailureThreshold int
+	SuccessThreshold int
+	// OnChange is called, when set, each time a check starts failing, with
+	// the error of its last probe, or recovers.
+	OnChange func(name string, err error)
+
+	mu    sync.Mutex
+	state map[string]*state
+}
+
+// state is the status of a check and the number of consecutive probes
+// contradicting it.
+type state struct {
+	failing bool
+	streak  int
+}
+
+// Run probes the checks right away and then every interval until ctx is done.
+// The checks are considered passing until they fail.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		c.Probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Probe runs every check once, concurrently, and updates the statuses.
+func (c *Checker) Probe(ctx context.Context) {
+	errs := make([]error, len(c.Checks))
+	var wg sync.WaitGroup
+	for i, check := range c.Checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+			defer cancel()
+			errs[i] = check.Probe(ctx)
+		}(i, check)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == nil {
+		c.state = map[string]*state{}
+	}
+	serving := true
+	for i, check := range c.Checks {
+		s, ok := c.state[check.Name]
+		if !ok {
+			s = &state{}
+			c.state[check.Name] = s
+		}
+		if s.update(errs[i], c.FailureThreshold, c.SuccessThreshold) && c.OnChange != nil {
+			c.OnChange(check.Name, errs[i])
+		}
+		c.Server.SetServingStatus(check.Name, status(!s.failing))
+		if check.Critical && s.failing {
+			serving = false
+		}
+	}
+	for _, service := range c.Services {
+		c.Server.SetServingStatus(service, status(serving))
+	}
+}
+
+// update records the result of a probe and tells whether the check
+// started failing or recovered.
+func (s *state) update(err error, failures, successes int) bool {
+	if (err != nil) == s.failing {
+		s.streak = 0
+		return false
+	}
+	s.streak++
+	if (s.failing && s.streak >= successes) || (!s.failing && s.streak >= failures) {
+		s.failing = !s.failing
+		s.streak = 0
+		return true
+	}
+	return false
+}
+
+func status(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,70 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestChecker(t *testing.T) {
+	server := health.NewServer()
+	var dbErr, agentErr error
+	var changes []string
+	c := &Checker{
+		Server:   server,
+		Services: []string{"", "todo.v1.TodoService"},
+		Checks: []Check{
+			{Name: "database", Probe: func(ctx context.Context) error { return dbErr }, Critical: true},
+			{Name: "tracer_agent", Probe: func(ctx context.Context) error { return agentErr }},
+		},
+		Timeout:          time.Second,
+		FailureThreshold: 2,
+		SuccessThreshold: 3,
+		OnChange:         func(name string, err error) { changes = append(changes, name) },
+	}
+	ctx := context.Background()
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := server.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		assert.Nil(t, err)
+		return res.Status
+	}
+	serving, notServing := healthpb.HealthCheckResponse_SERVING, healthpb.HealthCheckResponse_NOT_SERVING
+
+	c.Probe(ctx)
+	assert.Equal(t, serving, status("todo.v1.TodoService"))
+	assert.Equal(t, serving, status("database"))
+
+	// Failures of a non critical check do not affect the services
+	agentErr = errors.New("unreachable")
+	c.Probe(ctx)
+	c.Probe(ctx)
+	assert.Equal(t, notServing, status("tracer_agent"))
+	assert.Equal(t, serving, status("todo.v1.TodoService"))
+
+	// A single failure is tolerated
+	dbErr = errors.New("connection refused")
+	c.Probe(ctx)
+	assert.Equal(t, serving, status("todo.v1.TodoService"))
+	dbErr = nil
+	c.Probe(ctx)
+	dbErr = errors.New("connection refused")
+	c.Probe(ctx)
+	assert.Equal(t, serving, status("todo.v1.TodoService"))
+	c.Probe(ctx)
+	assert.Equal(t, notServing, status("todo.v1.TodoService"))
+	assert.Equal(t, notServing, status(""))
+
+	// Recovering takes consecutive successes
+	dbErr = nil
+	c.Probe(ctx)
+	c.Probe(ctx)
+	assert.Equal(t, notServing, status("todo.v1.TodoService"))
+	c.Probe(ctx)
+	assert.Equal(t, serving, status("todo.v1.TodoService"))
+	assert.Equal(t, []string{"tracer_agent", "database", "database"}, changes)
+}
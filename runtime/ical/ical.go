@@ -0,0 +1,290 @@
+// Package ical reads and writes todo items as iCalendar (RFC 5545) VTODO
+// components.
+//
+// SUMMARY, DESCRIPTION, DUE, CREATED, LAST-MODIFIED and CATEGORIES map to
+// the title, description, due_at, created_at, updated_at and tags of the
+// item, UID to its ID, or its iCalendar UID when set, and RRULE to its
+// recurrence. STATUS is COMPLETED for
+// completed items and NEEDS-ACTION otherwise, the workflow status of the
+// item is kept in X-GOTASKS-STATUS. As items do not record when they were
+// completed, COMPLETED is their last modification date.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	dateTimeLayout = "20060102T150405Z"
+	localLayout    = "20060102T150405"
+	dateLayout     = "20060102"
+
+	// ProdID identifies gotasks as the producer of the calendars.
+	ProdID = "-//gofunct//gotasks//EN"
+
+	// lineLength is the maximum length of a content line in octets,
+	// longer lines are folded.
+	lineLength = 75
+)
+
+// Format writes todo items as a VCALENDAR of VTODO components.
+func Format(w io.Writer, items []*todo.Todo) error {
+	bw := bufio.NewWriter(w)
+	write := func(name, value string) {
+		line := name + ":" + value
+		// Continuation lines start with a space
+		for max := lineLength; len(line) > max; max = lineLength - 1 {
+			n := max
+			// Do not split UTF-8 sequences
+			for n > 1 && line[n]&0xC0 == 0x80 {
+				n--
+			}
+			bw.WriteString(line[:n] + "\r\n ")
+			line = line[n:]
+		}
+		bw.WriteString(line + "\r\n")
+	}
+	stamp := time.Now().UTC().Format(dateTimeLayout)
+
+	write("BEGIN", "VCALENDAR")
+	write("VERSION", "2.0")
+	write("PRODID", ProdID)
+	for _, item := range items {
+		write("BEGIN", "VTODO")
+		uid := item.Id
+		if item.IcalUid != "" {
+			uid = item.IcalUid
+		}
+		write("UID", escape(uid))
+		write("DTSTAMP", stamp)
+		write("SUMMARY", escape(item.Title))
+		if item.Description != "" {
+			write("DESCRIPTION", escape(item.Description))
+		}
+		if item.Completed {
+			write("STATUS", "COMPLETED")
+			if item.UpdatedAt != nil {
+				write("COMPLETED", formatTime(item.UpdatedAt))
+			}
+		} else {
+			write("STATUS", "NEEDS-ACTION")
+		}
+		if item.Status != "" {
+			write("X-GOTASKS-STATUS", escape(item.Status))
+		}
+		if item.DueAt != nil {
+			write("DUE", formatTime(item.DueAt))
+		}
+		if item.Recurrence != "" {
+			write("RRULE", item.Recurrence)
+		}
+		if item.CreatedAt != nil {
+			write("CREATED", formatTime(item.CreatedAt))
+		}
+		if item.UpdatedAt != nil {
+			write("LAST-MODIFIED", formatTime(item.UpdatedAt))
+		}
+		if len(item.Tags) > 0 {
+			tags := make([]string, len(item.Tags))
+			for i, tag := range item.Tags {
+				tags[i] = escape(tag)
+			}
+			write("CATEGORIES", strings.Join(tags, ","))
+		}
+		write("END", "VTODO")
+	}
+	write("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// Parse reads the VTODO components of an iCalendar document,
+// other components are ignored.
+func Parse(r io.Reader) ([]*todo.Todo, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	var items []*todo.Todo
+	var item *todo.Todo
+	var completed *types.Timestamp
+	depth := 0
+	for n, line := range lines {
+		name, params, value, err := split(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", n+1, err)
+		}
+		switch {
+		case name == "BEGIN" && item == nil:
+			if strings.EqualFold(value, "VTODO") {
+				item, completed = &todo.Todo{}, nil
+			}
+			continue
+		case name == "BEGIN":
+			depth++
+			continue
+		case name == "END" && item != nil && depth > 0:
+			depth--
+			continue
+		case name == "END" && item != nil:
+			if item.UpdatedAt == nil {
+				item.UpdatedAt = completed
+			}
+			items = append(items, item)
+			item = nil
+			continue
+		case item == nil || depth > 0:
+			// Outside of a VTODO or in one of its alarms
+			continue
+		}
+
+		switch name {
+		case "UID":
+			item.Id = unescape(value)
+		case "SUMMARY":
+			item.Title = unescape(value)
+		case "DESCRIPTION":
+			item.Description = unescape(value)
+		case "STATUS":
+			item.Completed = strings.EqualFold(value, "COMPLETED")
+		case "RRULE":
+			item.Recurrence = value
+		case "X-GOTASKS-STATUS":
+			item.Status = unescape(value)
+		case "CATEGORIES":
+			for _, tag := range splitList(value) {
+				item.Tags = append(item.Tags, unescape(tag))
+			}
+		case "DUE", "CREATED", "LAST-MODIFIED", "COMPLETED":
+			ts, err := parseTime(value, params)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", n+1, err)
+			}
+			switch name {
+			case "DUE":
+				item.DueAt = ts
+			case "CREATED":
+				item.CreatedAt = ts
+			case "LAST-MODIFIED":
+				item.UpdatedAt = ts
+			case "COMPLETED":
+				completed = ts
+				item.Completed = true
+			}
+		}
+	}
+	return items, nil
+}
+
+// unfold joins the folded content lines of a document.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case line == "":
+			continue
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// split splits a content line into its upper cased name, its parameters and
+// its value. Quoted parameter values may contain colons and semicolons.
+func split(line string) (string, map[string]string, string, error) {
+	quoted := false
+	end := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return "", nil, "", fmt.Errorf("missing value in %q", line)
+	}
+	parts := strings.Split(line[:end], ";")
+	params := map[string]string{}
+	for _, p := range parts[1:] {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, line[end+1:], nil
+}
+
+// splitList splits a list of TEXT values on the unescaped commas.
+func splitList(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(values, value[start:])
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+var unescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
+
+func formatTime(ts *types.Timestamp) string {
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// parseTime reads a DATE or DATE-TIME value, times without time zone are
+// read in the zone of the TZID parameter or in UTC.
+func parseTime(value string, params map[string]string) (*types.Timestamp, error) {
+	loc := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	var t time.Time
+	var err error
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(dateTimeLayout, value)
+	case len(value) == len(dateLayout):
+		t, err = time.ParseInLocation(dateLayout, value, loc)
+	default:
+		t, err = time.ParseInLocation(localLayout, value, loc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q", value)
+	}
+	return types.TimestampProto(t)
+}
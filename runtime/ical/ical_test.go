@@ -0,0 +1,71 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundTrip(t *testing.T) {
+	items := []*todo.Todo{
+		{
+			Id:          "34d63bd4-56b3-4795-80d4-86e5db6fa0b5",
+			Title:       "Buy milk, eggs; bread",
+			Description: strings.Repeat("long description ", 10) + "\nsecond line é",
+			Completed:   true,
+			Status:      "done",
+			Tags:        []string{"home", "a,b"},
+			DueAt:       &types.Timestamp{Seconds: 1299715200},
+			Recurrence:  "FREQ=WEEKLY;INTERVAL=2",
+			CreatedAt:   &types.Timestamp{Seconds: 1298937600},
+			UpdatedAt:   &types.Timestamp{Seconds: 1299110400},
+		},
+		{Id: "d53daa2c-e6af-45ba-b192-3e1dc443b165", Title: "Call Mom"},
+	}
+	var buf bytes.Buffer
+	assert.Nil(t, Format(&buf, items))
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		assert.True(t, len(line) <= lineLength, line)
+	}
+	parsed, err := Parse(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, items, parsed)
+}
+
+func TestFormatCalendarUID(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, Format(&buf, []*todo.Todo{{Id: "34d63bd4-56b3-4795-80d4-86e5db6fa0b5", IcalUid: "task-1@example.com", Title: "a"}}))
+	parsed, err := Parse(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "task-1@example.com", parsed[0].Id)
+}
+
+func TestParse(t *testing.T) {
+	parsed, err := Parse(strings.NewReader(`BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VTODO
+UID:20070313T123432Z-456553@example.com
+SUMMARY:Submit Quebec
+  Income Tax Return
+DUE;VALUE=DATE:20070501
+COMPLETED:20070707T100000Z
+BEGIN:VALARM
+SUMMARY:alarm
+END:VALARM
+END:VTODO
+BEGIN:VEVENT
+SUMMARY:event
+END:VEVENT
+END:VCALENDAR
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(parsed))
+	assert.Equal(t, "Submit Quebec Income Tax Return", parsed[0].Title)
+	assert.Equal(t, int64(1177977600), parsed[0].DueAt.Seconds)
+	assert.True(t, parsed[0].Completed)
+	assert.Equal(t, int64(1183802400), parsed[0].UpdatedAt.Seconds)
+}
@@ -0,0 +1,86 @@
+// Package markdown renders the CommonMark descriptions of todo items as
+// sanitized HTML and counts the checkboxes of their task lists.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// RenderHTML is the value of the render parameter requesting HTML descriptions.
+const RenderHTML = "html"
+
+var (
+	md = goldmark.New(goldmark.WithExtensions(extension.TaskList, extension.Linkify))
+
+	// policy allows the user generated content and the disabled
+	// checkboxes of the task lists.
+	policy = func() *bluemonday.Policy {
+		p := bluemonday.UGCPolicy()
+		p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+		p.AllowAttrs("checked", "disabled").OnElements("input")
+		return p
+	}()
+)
+
+// Progress counts the checked and total checkboxes of the task lists
+// of a description, it returns nil when there are none.
+func Progress(description string) *todo.TaskProgress {
+	_, progress := parse([]byte(description))
+	return progress
+}
+
+// Render renders a description as sanitized HTML and counts
+// the checkboxes of its task lists.
+func Render(description string) (string, *todo.TaskProgress, error) {
+	src := []byte(description)
+	doc, progress := parse(src)
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, src, doc); err != nil {
+		return "", nil, err
+	}
+	return policy.Sanitize(buf.String()), progress, nil
+}
+
+// Apply sets the task progress of the items and, when render is html,
+// their HTML description.
+func Apply(render string, items ...*todo.Todo) error {
+	for _, item := range items {
+		if render != RenderHTML {
+			item.TaskProgress = Progress(item.Description)
+			continue
+		}
+		html, progress, err := Render(item.Description)
+		if err != nil {
+			return err
+		}
+		item.DescriptionHtml, item.TaskProgress = html, progress
+	}
+	return nil
+}
+
+func parse(src []byte) (ast.Node, *todo.TaskProgress) {
+	doc := md.Parser().Parse(text.NewReader(src))
+	progress := &todo.TaskProgress{}
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if box, ok := n.(*extast.TaskCheckBox); ok && entering {
+			progress.Total++
+			if box.IsChecked {
+				progress.Done++
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	if progress.Total == 0 {
+		return doc, nil
+	}
+	return doc, progress
+}
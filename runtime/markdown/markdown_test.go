@@ -0,0 +1,20 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	html, progress, err := Render("Checklist:\n\n- [x] laptop\n- [ ] badge\n- [X] [wiki](https://example.com)\n\n<script>alert(1)</script>[x](javascript:alert(1))")
+	assert.Nil(t, err)
+	assert.Equal(t, &todo.TaskProgress{Done: 2, Total: 3}, progress)
+	assert.Contains(t, html, `<input checked="" disabled="" type="checkbox">`)
+	assert.Contains(t, html, `<a href="https://example.com" rel="nofollow">wiki</a>`)
+	assert.NotContains(t, html, "script")
+	assert.NotContains(t, html, "javascript")
+
+	assert.Nil(t, Progress("no task list"))
+}
@@ -0,0 +1,267 @@
+// Package migrate applies versioned SQL migrations to the database of a
+// storage backend and records them in its schema_migrations table.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// file matches the names of migration files, <version>_<name>.up.sql
+// and <version>_<name>.down.sql.
+var file = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a versioned change of a database schema.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Load reads the migrations of a directory sorted by version. Every
+// migration has an up and a down file.
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := file.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migrate: unexpected file %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migrate: version %d is used by %s and %s", version, m.Name, match[2])
+		}
+		b, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migrate: %s misses its up or down file", m)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// MustLoad is like Load but panics on error, to load embedded migrations.
+func MustLoad(fsys fs.FS, dir string) []Migration {
+	migrations, err := Load(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return migrations
+}
+
+// Database is the database migrations are applied to.
+type Database interface {
+	// Locked runs fn in a transaction holding a lock which keeps other
+	// replicas from migrating the database at the same time. The
+	// schema_migrations table exists when fn is called.
+	Locked(ctx context.Context, fn func(Tx) error) error
+	// Versions returns the versions of the applied migrations without
+	// taking the lock nor creating schema_migrations, none when it is missing.
+	Versions(ctx context.Context) ([]int, error)
+}
+
+// versioner is implemented by Database and Tx.
+type versioner interface {
+	Versions(ctx context.Context) ([]int, error)
+}
+
+// Tx applies migrations inside of the transaction of Database.Locked.
+type Tx interface {
+	// Versions returns the versions of the applied migrations.
+	Versions(ctx context.Context) ([]int, error)
+	// Exec runs the statements of a migration.
+	Exec(ctx context.Context, script string) error
+	// Insert records a migration as applied.
+	Insert(ctx context.Context, m Migration) error
+	// Delete records a migration as reverted.
+	Delete(ctx context.Context, version int) error
+}
+
+// Migrator migrates a database to the latest of its migrations.
+type Migrator struct {
+	DB         Database
+	Migrations []Migration
+}
+
+// Status tells whether a migration is applied.
+type Status struct {
+	Migration
+	Applied bool
+}
+
+// applied returns the applied versions, failing on versions it does not know
+// about, which were applied by a newer release.
+func (m Migrator) applied(ctx context.Context, v versioner) (map[int]bool, error) {
+	versions, err := v.Versions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[int]bool, len(m.Migrations))
+	for _, migration := range m.Migrations {
+		known[migration.Version] = true
+	}
+	applied := make(map[int]bool, len(versions))
+	for _, v := range versions {
+		if !known[v] {
+			return nil, fmt.Errorf("migrate: the database has unknown migration %04d, it was migrated by a newer release", v)
+		}
+		applied[v] = true
+	}
+	return applied, nil
+}
+
+// Up applies the pending migrations in a single transaction
+// and returns them.
+func (m Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.DB.Locked(ctx, func(tx Tx) error {
+		applied, err := m.applied(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.Migrations {
+			if applied[migration.Version] {
+				continue
+			}
+			if err := tx.Exec(ctx, migration.Up); err != nil {
+				return fmt.Errorf("migrate: %s: %s", migration, err)
+			}
+			if err := tx.Insert(ctx, migration); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+// Down reverts the last steps applied migrations in a single
+// transaction and returns them.
+func (m Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.DB.Locked(ctx, func(tx Tx) error {
+		applied, err := m.applied(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for i := len(m.Migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := m.Migrations[i]
+			if !applied[migration.Version] {
+				continue
+			}
+			if err := tx.Exec(ctx, migration.Down); err != nil {
+				return fmt.Errorf("migrate: %s: %s", migration, err)
+			}
+			if err := tx.Delete(ctx, migration.Version); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+// Status returns every migration and whether it is applied. It does not
+// wait for a running migration, nor needs the privileges to migrate.
+func (m Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx, m.DB)
+	if err != nil {
+		return nil, err
+	}
+	status := make([]Status, 0, len(m.Migrations))
+	for _, migration := range m.Migrations {
+		status = append(status, Status{Migration: migration, Applied: applied[migration.Version]})
+	}
+	return status, nil
+}
+
+// Check returns an error unless every migration is applied,
+// so that the server does not run on a schema it does not expect.
+func (m Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending int
+	for _, s := range status {
+		if !s.Applied {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("migrate: %d pending migrations, run gotasks migrate up", pending)
+	}
+	return nil
+}
+
+// Create writes the empty up and down files of a new migration in dir,
+// numbered after the migrations already there, and returns their paths.
+func Create(dir, name string) ([]string, error) {
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(name) {
+		return nil, fmt.Errorf("migrate: invalid name %q, use lowercase letters, digits and _", name)
+	}
+	migrations, err := Load(os.DirFS(dir), ".")
+	if err != nil {
+		return nil, err
+	}
+	version := 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+	m := Migration{Version: version, Name: name}
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		p := filepath.Join(dir, fmt.Sprintf("%s.%s.sql", m, direction))
+		f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(f, "-- %s %s\n", m, direction)
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
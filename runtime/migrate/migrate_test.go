@@ -0,0 +1,123 @@
+package migrate
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDB records the applied versions, the executed scripts and the
+// number of times it was locked.
+type fakeDB struct {
+	versions map[int]string
+	scripts  []string
+	locks    int
+}
+
+func (db *fakeDB) Locked(ctx context.Context, fn func(Tx) error) error {
+	db.locks++
+	return fn(db)
+}
+
+func (db *fakeDB) Versions(ctx context.Context) ([]int, error) {
+	var versions []int
+	for v := range db.versions {
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+	return versions, nil
+}
+
+func (db *fakeDB) Exec(ctx context.Context, script string) error {
+	db.scripts = append(db.scripts, script)
+	return nil
+}
+
+func (db *fakeDB) Insert(ctx context.Context, m Migration) error {
+	db.versions[m.Version] = m.Name
+	return nil
+}
+
+func (db *fakeDB) Delete(ctx context.Context, version int) error {
+	delete(db.versions, version)
+	return nil
+}
+
+var files = fstest.MapFS{
+	"migrations/0002_indexes.up.sql":   {Data: []byte("create index")},
+	"migrations/0002_indexes.down.sql": {Data: []byte("drop index")},
+	"migrations/0001_initial.up.sql":   {Data: []byte("create table")},
+	"migrations/0001_initial.down.sql": {Data: []byte("drop table")},
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load(files, "migrations")
+	assert.Nil(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 1, Name: "initial", Up: "create table", Down: "drop table"},
+		{Version: 2, Name: "indexes", Up: "create index", Down: "drop index"},
+	}, migrations)
+	assert.Equal(t, "0002_indexes", migrations[1].String())
+
+	_, err = Load(fstest.MapFS{"m/0001_initial.up.sql": {Data: []byte("create table")}}, "m")
+	assert.Error(t, err)
+	_, err = Load(fstest.MapFS{"m/initial.sql": {Data: []byte("create table")}}, "m")
+	assert.Error(t, err)
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := &fakeDB{versions: map[int]string{}}
+	m := Migrator{DB: db, Migrations: MustLoad(files, "migrations")}
+	assert.Error(t, m.Check(ctx))
+	// The status is read without waiting for the lock
+	assert.Equal(t, 0, db.locks)
+
+	done, err := m.Up(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, done, 2)
+	assert.Nil(t, m.Check(ctx))
+	done, err = m.Up(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, done)
+
+	done, err = m.Down(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "0002_indexes", done[0].String())
+	status, err := m.Status(ctx)
+	assert.Nil(t, err)
+	assert.True(t, status[0].Applied)
+	assert.False(t, status[1].Applied)
+	assert.Error(t, m.Check(ctx))
+	assert.Equal(t, []string{"create table", "create index", "drop index"}, db.scripts)
+
+	// A database migrated by a newer release is refused
+	db.versions[3] = "newer"
+	_, err = m.Up(ctx)
+	assert.Error(t, err)
+	assert.Error(t, m.Check(ctx))
+}
+
+func TestCreate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	paths, err := Create(dir, "initial")
+	assert.Nil(t, err)
+	assert.Len(t, paths, 2)
+	_, err = Create(dir, "add_index")
+	assert.Nil(t, err)
+	_, err = Create(dir, "Bad Name")
+	assert.Error(t, err)
+
+	migrations, err := Load(os.DirFS(dir), ".")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, migrations[1].Version)
+	assert.Equal(t, "add_index", migrations[1].Name)
+}
@@ -0,0 +1,142 @@
+// Package outbox records the changes of the todos as events written in the
+// transaction of each change, and relays them to a Sink. Events are never
+// lost, they are published at least once, and the events of a todo are
+// published in order.
+package outbox
+
+import (
+	"context"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// Repository appends an event to the outbox for each todo changed by its
+// writes, in the same transaction as the write.
+type Repository struct {
+	storage.Repository
+}
+
+// New returns repo recording the changes of the todos in its outbox.
+func New(repo storage.Repository) Repository {
+	return Repository{Repository: repo}
+}
+
+// Transaction runs fn with a repository appending the events of its
+// writes in the transaction.
+func (r Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	return r.Repository.Transaction(ctx, func(repo storage.Repository) error {
+		return fn(tx{repo})
+	})
+}
+
+// CreateTodos inserts items with their CREATED events.
+func (r Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.Transaction(ctx, func(repo storage.Repository) error {
+		return repo.CreateTodos(ctx, items...)
+	})
+}
+
+// UpdateTodos updates items with their UPDATED events.
+func (r Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.Transaction(ctx, func(repo storage.Repository) error {
+		return repo.UpdateTodos(ctx, items...)
+	})
+}
+
+// AddTrackedSeconds updates an item with its UPDATED event.
+func (r Repository) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	return r.Transaction(ctx, func(repo storage.Repository) error {
+		return repo.AddTrackedSeconds(ctx, id, seconds)
+	})
+}
+
+// DeleteTodos deletes the items matching f with their DELETED events.
+func (r Repository) DeleteTodos(ctx context.Context, f storage.Filter) (deleted int, err error) {
+	err = r.Transaction(ctx, func(repo storage.Repository) error {
+		deleted, err = repo.DeleteTodos(ctx, f)
+		return err
+	})
+	return deleted, err
+}
+
+// tx is the repository of a transaction. Its writes read back the changed
+// todos, so that the events hold them as they are stored.
+type tx struct {
+	storage.Repository
+}
+
+func (t tx) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	return fn(t)
+}
+
+func (t tx) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	if err := t.Repository.CreateTodos(ctx, items...); err != nil {
+		return err
+	}
+	return t.append(ctx, todo.TodoEvent_CREATED, items)
+}
+
+func (t tx) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	if err := t.Repository.UpdateTodos(ctx, items...); err != nil {
+		return err
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.Id
+	}
+	return t.changed(ctx, ids)
+}
+
+func (t tx) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	if err := t.Repository.AddTrackedSeconds(ctx, id, seconds); err != nil {
+		return err
+	}
+	return t.changed(ctx, []string{id})
+}
+
+// DeleteTodos deletes the todos matching f when it is called, by ID, so
+// that every deleted todo has its event.
+func (t tx) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	items, err := t.Repository.ListTodos(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.Id
+	}
+	deleted, err := t.Repository.DeleteTodos(ctx, storage.Filter{IDs: ids})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, t.append(ctx, todo.TodoEvent_DELETED, items)
+}
+
+// changed appends an UPDATED event for each existing todo of ids.
+func (t tx) changed(ctx context.Context, ids []string) error {
+	items, err := t.Repository.ListTodos(ctx, storage.Filter{IDs: ids})
+	if err != nil {
+		return err
+	}
+	return t.append(ctx, todo.TodoEvent_UPDATED, items)
+}
+
+func (t tx) append(ctx context.Context, typ todo.TodoEvent_Type, items []*todo.Todo) error {
+	if len(items) == 0 {
+		return nil
+	}
+	now := types.TimestampNow()
+	events := make([]*todo.TodoEvent, len(items))
+	for i, item := range items {
+		events[i] = &todo.TodoEvent{
+			TodoId:    item.Id,
+			Type:      typ,
+			Item:      proto.Clone(item).(*todo.Todo),
+			CreatedAt: now,
+		}
+	}
+	return t.Repository.AppendEvents(ctx, events...)
+}
@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/memory"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// flakySink fails to publish the events of a todo once.
+type flakySink struct {
+	MemorySink
+	fail string
+}
+
+func (s *flakySink) Publish(ctx context.Context, event *todo.TodoEvent) error {
+	if event.TodoId == s.fail {
+		s.fail = ""
+		return errors.New("unavailable")
+	}
+	return s.MemorySink.Publish(ctx, event)
+}
+
+func TestOutbox(t *testing.T) {
+	ctx := context.Background()
+	backend := memory.New()
+	repo := New(backend)
+	assert.Nil(t, repo.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "a"}, &todo.Todo{Id: "2", Title: "b"}))
+	assert.Nil(t, repo.UpdateTodos(ctx, &todo.Todo{Id: "1", Title: "c"}, &todo.Todo{Id: "3"}))
+	assert.Nil(t, repo.AddTrackedSeconds(ctx, "2", 60))
+	deleted, err := repo.DeleteTodos(ctx, storage.Filter{IDs: []string{"1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+	// A failed transaction appends no event
+	assert.Error(t, repo.Transaction(ctx, func(tx storage.Repository) error {
+		assert.Nil(t, tx.CreateTodos(ctx, &todo.Todo{Id: "4"}))
+		return errors.New("failed")
+	}))
+
+	sink := &flakySink{fail: "2"}
+	relay := &Relay{Repo: backend, Sink: sink, BatchSize: 10}
+	published := 0
+	for i := 0; i < 10; i++ {
+		n, _ := relay.Publish(ctx)
+		published += n
+	}
+	assert.Equal(t, 5, published)
+
+	type change struct {
+		id    string
+		typ   todo.TodoEvent_Type
+		title string
+	}
+	var changes []change
+	for _, event := range sink.Events() {
+		changes = append(changes, change{event.TodoId, event.Type, event.Item.Title})
+	}
+	// The events of each todo are in order despite the failure
+	assert.Equal(t, []change{
+		{"1", todo.TodoEvent_CREATED, "a"},
+		{"2", todo.TodoEvent_CREATED, "b"},
+		{"1", todo.TodoEvent_UPDATED, "c"},
+		{"2", todo.TodoEvent_UPDATED, "b"},
+		{"1", todo.TodoEvent_DELETED, "c"},
+	}, changes)
+	assert.Equal(t, int64(60), sink.Events()[3].Item.TrackedSeconds)
+	events, err := backend.ClaimEvents(ctx, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestRelayRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	backend := memory.New()
+	sink := &MemorySink{}
+	relay := &Relay{Repo: backend, Sink: sink, BatchSize: 1, Interval: time.Millisecond}
+	assert.Nil(t, New(backend).CreateTodos(ctx, &todo.Todo{Id: "1"}, &todo.Todo{Id: "2"}))
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+	for deadline := time.Now().Add(time.Second); len(sink.Events()) < 2 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Len(t, sink.Events(), 2)
+	cancel()
+	<-done
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "outbox")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.jsonl")
+	sink, err := OpenFileSink(path)
+	assert.Nil(t, err)
+	ctx := context.Background()
+	assert.Nil(t, sink.Publish(ctx, &todo.TodoEvent{Id: 1, TodoId: "1", Item: &todo.Todo{Id: "1"}}))
+	assert.Nil(t, sink.Publish(ctx, &todo.TodoEvent{Id: 2, TodoId: "1", Type: todo.TodoEvent_DELETED}))
+	assert.Nil(t, sink.Close())
+
+	b, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":"1","todo_id":"1","item":{"id":"1"}}`+"\n"+`{"id":"2","todo_id":"1","type":"DELETED"}`+"\n", string(b))
+}
+
+func TestConformance(t *testing.T) {
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository { return New(memory.New()) },
+	})
+}
@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+)
+
+// Sink receives the published events.
+type Sink interface {
+	// Publish delivers an event, the event is published again when it
+	// returns an error. An event may be delivered more than once, such as
+	// when the relay stops right after publishing it.
+	Publish(ctx context.Context, event *todo.TodoEvent) error
+}
+
+// Relay publishes the events of the outbox of a repository to a sink.
+// Several relays may publish the same outbox, the postgres driver hands
+// the events of a todo to a single relay at a time.
+type Relay struct {
+	Repo      storage.Repository
+	Sink      Sink
+	BatchSize int
+	// Interval is the time waited once the outbox is empty.
+	Interval time.Duration
+	// OnError is called, when set, with the errors of the batches.
+	OnError func(err error)
+}
+
+// Run publishes the events until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		n, err := r.Publish(ctx)
+		if err != nil && r.OnError != nil && ctx.Err() == nil {
+			r.OnError(err)
+		}
+		if n == r.BatchSize && err == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.Interval):
+		}
+	}
+}
+
+// Publish publishes a batch of events in a transaction, deleting them from
+// the outbox as they are published, and returns the number of events
+// published. It stops at the first event the sink fails to publish, the
+// next events of the todo are published after it. The events of every
+// tenant are published, see storage.WithAllTenants.
+func (r *Relay) Publish(ctx context.Context) (int, error) {
+	ctx = storage.WithAllTenants(ctx)
+	var published []int64
+	var publishErr error
+	err := r.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		events, err := tx.ClaimEvents(ctx, r.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if publishErr = r.Sink.Publish(ctx, event); publishErr != nil {
+				break
+			}
+			published = append(published, event.Id)
+		}
+		return tx.DeleteEvents(ctx, published...)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(published), publishErr
+}
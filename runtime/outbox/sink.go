@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// MemorySink keeps the published events in memory, for tests and for
+// embedding the service.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []*todo.TodoEvent
+}
+
+// Publish appends event to the events of the sink.
+func (s *MemorySink) Publish(ctx context.Context, event *todo.TodoEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, proto.Clone(event).(*todo.TodoEvent))
+	return nil
+}
+
+// Events returns the published events in the order they were published.
+func (s *MemorySink) Events() []*todo.TodoEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := make([]*todo.TodoEvent, len(s.events))
+	for i, event := range s.events {
+		events[i] = proto.Clone(event).(*todo.TodoEvent)
+	}
+	return events
+}
+
+// FileSink appends the published events to a file as JSON lines, in the
+// JSON mapping of the API.
+type FileSink struct {
+	mu sync.Mutex
+	f  *os.File
+	m  jsonpb.Marshaler
+}
+
+// OpenFileSink opens the file at path for appending, creating it when it
+// does not exist.
+func OpenFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{f: f, m: jsonpb.Marshaler{OrigName: true}}, nil
+}
+
+// Publish writes event and syncs the file, so that the event is on disk
+// before it is deleted from the outbox.
+func (s *FileSink) Publish(ctx context.Context, event *todo.TodoEvent) error {
+	line, err := s.m.MarshalToString(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.f.WriteString(line + "\n"); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.f.Close()
+}
@@ -18,6 +18,10 @@ func main() {
 		vanity.TurnOnStringerAll,
 		vanity.TurnOnUnmarshalerAll,
 		vanity.TurnOnSizerAll,
+		// The messages are stored by go-pg, which would map the XXX_ fields to columns
+		vanity.TurnOffGoUnkeyedAll,
+		vanity.TurnOffGoUnrecognizedAll,
+		vanity.TurnOffGoSizecacheAll,
 	} {
 		vanity.ForEachFile(files, opt)
 	}
@@ -0,0 +1,263 @@
+// Package quickadd parses sentences such as
+// "pay rent tomorrow 9am #finance !high every month" into todo items.
+//
+// The words of the sentence are read from left to right, the first match
+// of each kind wins and the remaining words form the title:
+//
+//   - #tag adds a tag
+//   - !high, !medium, !low or !A to !Z set the priority
+//   - today, tomorrow, a weekday, "in 3 days" or 2006-01-02, optionally
+//     preceded by on, by or due, set the due date
+//   - 9am, 9:30pm, 21:00, noon or midnight, optionally preceded by at,
+//     set the due time, today when there is no date
+//   - daily, weekly, monthly, yearly, "every month", "every 2 weeks",
+//     "every weekday" or "every monday" set the recurrence
+//
+// Dates are evaluated against the clock of the parser in the time zone
+// of the user. Due dates without time are due at the start of the day.
+package quickadd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/todotxt"
+	"github.com/gogo/protobuf/types"
+)
+
+// Fields the tokens of a sentence are parsed into.
+const (
+	FieldTags       = "tags"
+	FieldPriority   = "priority"
+	FieldDueAt      = "due_at"
+	FieldRecurrence = "recurrence"
+)
+
+// Token is a part of a sentence recognized as a field of the item.
+type Token struct {
+	Text  string
+	Field string
+}
+
+// Parser parses sentences into todo items.
+type Parser struct {
+	// Now returns the current time, time.Now when nil.
+	Now func() time.Time
+}
+
+var (
+	priorities = map[string]string{"high": "A", "medium": "B", "low": "C"}
+	weekdays   = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	}
+	units = map[string]string{
+		"day": "DAILY", "days": "DAILY", "week": "WEEKLY", "weeks": "WEEKLY",
+		"month": "MONTHLY", "months": "MONTHLY", "year": "YEARLY", "years": "YEARLY",
+	}
+	adverbs = map[string]string{"daily": "DAILY", "weekly": "WEEKLY", "monthly": "MONTHLY", "yearly": "YEARLY"}
+
+	letter   = regexp.MustCompile(`^[A-Z]$`)
+	clock12  = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	clock24  = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	isoDate  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	connects = map[string]bool{"on": true, "by": true, "due": true}
+)
+
+// Parse parses a sentence into a todo item, evaluating dates in loc.
+func (p Parser) Parse(text string, loc *time.Location) (*todo.Todo, []Token) {
+	now := time.Now
+	if p.Now != nil {
+		now = p.Now
+	}
+	today := now().In(loc)
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, loc)
+
+	item := &todo.Todo{}
+	var tokens []Token
+	var title []string
+	var date *time.Time
+	var clock *time.Duration
+
+	words := strings.Fields(text)
+	for i := 0; i < len(words); {
+		word := words[i]
+		lower := strings.ToLower(word)
+		matched := func(n int, field string) {
+			tokens = append(tokens, Token{Text: strings.Join(words[i:i+n], " "), Field: field})
+			i += n
+		}
+
+		if len(word) > 1 && word[0] == '#' {
+			item.Tags = append(item.Tags, word[1:])
+			matched(1, FieldTags)
+			continue
+		}
+		if len(word) > 1 && word[0] == '!' && item.CustomFields == nil {
+			if pri, ok := priorities[lower[1:]]; ok {
+				item.CustomFields = map[string]string{todotxt.PriorityField: pri}
+				matched(1, FieldPriority)
+				continue
+			}
+			if letter.MatchString(word[1:]) {
+				item.CustomFields = map[string]string{todotxt.PriorityField: word[1:]}
+				matched(1, FieldPriority)
+				continue
+			}
+		}
+		if item.Recurrence == "" {
+			if rule, n := recurrence(words[i:]); n > 0 {
+				item.Recurrence = rule
+				matched(n, FieldRecurrence)
+				continue
+			}
+		}
+		if date == nil {
+			skip := 0
+			if connects[lower] && i+1 < len(words) {
+				skip = 1
+			}
+			if d, n := day(words[i+skip:], today); n > 0 {
+				date = &d
+				matched(skip+n, FieldDueAt)
+				continue
+			}
+		}
+		if clock == nil {
+			skip := 0
+			if lower == "at" && i+1 < len(words) {
+				skip = 1
+			}
+			if c, ok := timeOfDay(strings.ToLower(words[i+skip])); ok {
+				clock = &c
+				matched(skip+1, FieldDueAt)
+				continue
+			}
+		}
+		title = append(title, word)
+		i++
+	}
+
+	item.Title = strings.Join(title, " ")
+	if date != nil || clock != nil {
+		due := today
+		if date != nil {
+			due = *date
+		}
+		if clock != nil {
+			// Added to midnight, the clock would be off on the days of DST changes
+			h, m := int(*clock/time.Hour), int(*clock%time.Hour/time.Minute)
+			due = time.Date(due.Year(), due.Month(), due.Day(), h, m, 0, 0, loc)
+		}
+		item.DueAt, _ = types.TimestampProto(due)
+	}
+	return item, tokens
+}
+
+// day parses a date at the start of words and returns the number of words used.
+func day(words []string, today time.Time) (time.Time, int) {
+	if len(words) == 0 {
+		return time.Time{}, 0
+	}
+	w := strings.ToLower(words[0])
+	switch {
+	case w == "today":
+		return today, 1
+	case w == "tomorrow":
+		return today.AddDate(0, 0, 1), 1
+	case isoDate.MatchString(w):
+		d, err := time.ParseInLocation("2006-01-02", w, today.Location())
+		if err == nil {
+			return d, 1
+		}
+	case w == "in" && len(words) >= 3:
+		n, err := strconv.Atoi(words[1])
+		if err != nil || n <= 0 {
+			break
+		}
+		switch units[strings.ToLower(words[2])] {
+		case "DAILY":
+			return today.AddDate(0, 0, n), 3
+		case "WEEKLY":
+			return today.AddDate(0, 0, 7*n), 3
+		case "MONTHLY":
+			return today.AddDate(0, n, 0), 3
+		case "YEARLY":
+			return today.AddDate(n, 0, 0), 3
+		}
+	}
+	if wd, ok := weekdays[w]; ok {
+		// Next occurrence of the weekday, a week from today for today's weekday
+		days := (int(wd)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days), 1
+	}
+	return time.Time{}, 0
+}
+
+// timeOfDay parses a time of day into the duration since midnight.
+func timeOfDay(w string) (time.Duration, bool) {
+	switch w {
+	case "noon":
+		return 12 * time.Hour, true
+	case "midnight":
+		return 0, true
+	}
+	var h, m int
+	if match := clock12.FindStringSubmatch(w); match != nil {
+		h, _ = strconv.Atoi(match[1])
+		if match[2] != "" {
+			m, _ = strconv.Atoi(match[2])
+		}
+		if h < 1 || h > 12 {
+			return 0, false
+		}
+		h %= 12
+		if match[3] == "pm" {
+			h += 12
+		}
+	} else if match := clock24.FindStringSubmatch(w); match != nil {
+		h, _ = strconv.Atoi(match[1])
+		m, _ = strconv.Atoi(match[2])
+		if h > 23 {
+			return 0, false
+		}
+	} else {
+		return 0, false
+	}
+	if m > 59 {
+		return 0, false
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, true
+}
+
+// recurrence parses a recurrence at the start of words into an RRULE
+// value and returns the number of words used.
+func recurrence(words []string) (string, int) {
+	w := strings.ToLower(words[0])
+	if freq, ok := adverbs[w]; ok {
+		return "FREQ=" + freq, 1
+	}
+	if w != "every" || len(words) < 2 {
+		return "", 0
+	}
+	next := strings.ToLower(words[1])
+	if freq, ok := units[next]; ok {
+		return "FREQ=" + freq, 2
+	}
+	if next == "weekday" {
+		return "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", 2
+	}
+	if wd, ok := weekdays[next]; ok {
+		return "FREQ=WEEKLY;BYDAY=" + strings.ToUpper(wd.String()[:2]), 2
+	}
+	if n, err := strconv.Atoi(next); err == nil && n > 0 && len(words) >= 3 {
+		if freq, ok := units[strings.ToLower(words[2])]; ok {
+			return fmt.Sprintf("FREQ=%s;INTERVAL=%d", freq, n), 3
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,78 @@
+package quickadd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofunct/gotasks/runtime/todotxt"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Wednesday 2019-01-02 at 15:00 in Paris
+var now = func() time.Time { return time.Date(2019, 1, 2, 14, 0, 0, 0, time.UTC) }
+
+func due(t *testing.T, text string) time.Time {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.Nil(t, err)
+	item, _ := Parser{Now: now}.Parse(text, paris)
+	if !assert.NotNil(t, item.DueAt, text) {
+		return time.Time{}
+	}
+	d, err := types.TimestampFromProto(item.DueAt)
+	assert.Nil(t, err)
+	return d.In(paris)
+}
+
+func TestParse(t *testing.T) {
+	item, tokens := Parser{Now: now}.Parse("pay rent tomorrow 9am #finance !high every month", time.UTC)
+	assert.Equal(t, "pay rent", item.Title)
+	assert.Equal(t, []string{"finance"}, item.Tags)
+	assert.Equal(t, "A", item.CustomFields[todotxt.PriorityField])
+	assert.Equal(t, "FREQ=MONTHLY", item.Recurrence)
+	assert.Equal(t, int64(1546506000), item.DueAt.Seconds)
+	assert.Equal(t, []Token{
+		{"tomorrow", FieldDueAt},
+		{"9am", FieldDueAt},
+		{"#finance", FieldTags},
+		{"!high", FieldPriority},
+		{"every month", FieldRecurrence},
+	}, tokens)
+
+	item, _ = Parser{Now: now}.Parse("call mom", time.UTC)
+	assert.Equal(t, "call mom", item.Title)
+	assert.Nil(t, item.DueAt)
+
+	item, _ = Parser{Now: now}.Parse("standup every weekday at 9:30am", time.UTC)
+	assert.Equal(t, "standup", item.Title)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", item.Recurrence)
+
+	item, _ = Parser{Now: now}.Parse("water plants every 2 weeks", time.UTC)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2", item.Recurrence)
+}
+
+func TestParseDue(t *testing.T) {
+	assert.Equal(t, "2019-01-02 00:00", due(t, "report today").Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-01-02 21:00", due(t, "report 9pm").Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-01-09 12:00", due(t, "report on wednesday at noon").Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-01-04 00:00", due(t, "report friday").Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-02-02 18:30", due(t, "report in 1 month 18:30").Format("2006-01-02 15:04"))
+	assert.Equal(t, "2019-03-01 00:00", due(t, "report by 2019-03-01").Format("2006-01-02 15:04"))
+}
+
+func TestParseDueDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.Nil(t, err)
+	// Clocks move forward on 2019-03-10 at 2:00
+	now := func() time.Time { return time.Date(2019, 3, 9, 17, 0, 0, 0, time.UTC) }
+	for text, want := range map[string]string{
+		"report tomorrow 9am":      "2019-03-10 09:00 EDT",
+		"report tomorrow midnight": "2019-03-10 00:00 EST",
+		"report tomorrow 3:30am":   "2019-03-10 03:30 EDT",
+	} {
+		item, _ := Parser{Now: now}.Parse(text, newYork)
+		d, err := types.TimestampFromProto(item.DueAt)
+		assert.Nil(t, err)
+		assert.Equal(t, want, d.In(newYork).Format("2006-01-02 15:04 MST"), text)
+	}
+}
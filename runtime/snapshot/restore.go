@@ -0,0 +1,266 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+)
+
+// Policy tells Restore what to do with the records already in the database.
+type Policy string
+
+const (
+	// Fail aborts the restore on the first record already in the database.
+	Fail Policy = "error"
+	// Skip keeps the rows of the database and ignores their records.
+	Skip Policy = "skip"
+	// Overwrite replaces the rows of the database with their records, the
+	// time entries of the todos included.
+	Overwrite Policy = "overwrite"
+)
+
+// Options of Restore.
+type Options struct {
+	OnConflict Policy
+	// BatchSize is the number of todos restored per transaction.
+	BatchSize int
+	// Driver and SchemaVersion describe the database restored to. The
+	// snapshots of a newer schema of the same driver are refused.
+	Driver        string
+	SchemaVersion int64
+}
+
+// Result counts the restored and skipped records.
+type Result struct {
+	Todos       int
+	TimeEntries int
+	Schemas     int
+	Templates   int
+	Skipped     int
+}
+
+// Restore verifies the snapshot of r, then upserts its records into repo
+// according to the conflict policy. The todos are restored in batches of
+// one transaction each, so a failed restore keeps the batches before it.
+func Restore(ctx context.Context, repo storage.Repository, r io.ReadSeeker, opts Options) (*Result, error) {
+	switch opts.OnConflict {
+	case Fail, Skip, Overwrite:
+	default:
+		return nil, fmt.Errorf("unknown conflict policy %q, use error, skip or overwrite", opts.OnConflict)
+	}
+	if opts.BatchSize <= 0 {
+		return nil, fmt.Errorf("invalid batch size %d", opts.BatchSize)
+	}
+	header, err := Verify(r)
+	if err != nil {
+		return nil, err
+	}
+	if header.Driver == opts.Driver && header.SchemaVersion > opts.SchemaVersion {
+		return nil, fmt.Errorf("snapshot of schema version %d is newer than the database at version %d, migrate it first", header.SchemaVersion, opts.SchemaVersion)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	sr, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{}
+	b := &batch{policy: opts.OnConflict, res: res}
+	for {
+		record, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return res, err
+		}
+		if _, ok := record.Record.(*todo.SnapshotRecord_Todo); ok && len(b.todos) == opts.BatchSize {
+			if err := b.flush(ctx, repo); err != nil {
+				return res, err
+			}
+		}
+		if err := b.add(record); err != nil {
+			return res, err
+		}
+	}
+	return res, b.flush(ctx, repo)
+}
+
+// batch holds the records restored in a transaction.
+type batch struct {
+	policy    Policy
+	res       *Result
+	schemas   []*todo.CustomFieldSchema
+	templates []*todo.TodoTemplate
+	todos     []*todo.Todo
+	entries   map[string][]*todo.TimeEntry
+}
+
+func (b *batch) add(record *todo.SnapshotRecord) error {
+	switch r := record.Record.(type) {
+	case *todo.SnapshotRecord_CustomFieldSchema:
+		b.schemas = append(b.schemas, r.CustomFieldSchema)
+	case *todo.SnapshotRecord_Template:
+		b.templates = append(b.templates, r.Template)
+	case *todo.SnapshotRecord_Todo:
+		b.todos = append(b.todos, r.Todo)
+	case *todo.SnapshotRecord_TimeEntry:
+		if len(b.todos) == 0 || b.todos[len(b.todos)-1].Id != r.TimeEntry.TodoId {
+			return fmt.Errorf("time entry %s does not follow its todo %s", r.TimeEntry.Id, r.TimeEntry.TodoId)
+		}
+		if b.entries == nil {
+			b.entries = map[string][]*todo.TimeEntry{}
+		}
+		b.entries[r.TimeEntry.TodoId] = append(b.entries[r.TimeEntry.TodoId], r.TimeEntry)
+	default:
+		return fmt.Errorf("unknown snapshot record %T", record.Record)
+	}
+	return nil
+}
+
+// flush restores the records of the batch in a transaction and empties it.
+func (b *batch) flush(ctx context.Context, repo storage.Repository) error {
+	var res Result
+	err := repo.Transaction(ctx, func(tx storage.Repository) error {
+		res = Result{}
+		if err := b.restoreSchemas(ctx, tx, &res); err != nil {
+			return err
+		}
+		if err := b.restoreTemplates(ctx, tx, &res); err != nil {
+			return err
+		}
+		return b.restoreTodos(ctx, tx, &res)
+	})
+	if err != nil {
+		return err
+	}
+	b.res.Todos += res.Todos
+	b.res.TimeEntries += res.TimeEntries
+	b.res.Schemas += res.Schemas
+	b.res.Templates += res.Templates
+	b.res.Skipped += res.Skipped
+	*b = batch{policy: b.policy, res: b.res}
+	return nil
+}
+
+func (b *batch) restoreSchemas(ctx context.Context, tx storage.Repository, res *Result) error {
+	if len(b.schemas) == 0 {
+		return nil
+	}
+	lists := make([]string, len(b.schemas))
+	for i, schema := range b.schemas {
+		lists[i] = schema.List
+	}
+	existing, err := tx.CustomFieldSchemas(ctx, lists...)
+	if err != nil {
+		return err
+	}
+	found := map[string]bool{}
+	for _, schema := range existing {
+		found[schema.List] = true
+	}
+	for _, schema := range b.schemas {
+		if found[schema.List] {
+			switch b.policy {
+			case Fail:
+				return fmt.Errorf("custom field schema of list %q: %s", schema.List, storage.ErrAlreadyExists)
+			case Skip:
+				res.Skipped++
+				continue
+			}
+		}
+		if err := tx.PutCustomFieldSchema(ctx, schema); err != nil {
+			return err
+		}
+		res.Schemas++
+	}
+	return nil
+}
+
+func (b *batch) restoreTemplates(ctx context.Context, tx storage.Repository, res *Result) error {
+	for _, template := range b.templates {
+		_, err := tx.GetTemplate(ctx, template.Id)
+		switch {
+		case err == storage.ErrNotFound:
+		case err != nil:
+			return err
+		case b.policy == Fail:
+			return fmt.Errorf("template %s: %s", template.Id, storage.ErrAlreadyExists)
+		case b.policy == Skip:
+			res.Skipped++
+			continue
+		default:
+			if err := tx.DeleteTemplate(ctx, template.Id); err != nil {
+				return err
+			}
+		}
+		if err := tx.CreateTemplate(ctx, template); err != nil {
+			return err
+		}
+		res.Templates++
+	}
+	return nil
+}
+
+func (b *batch) restoreTodos(ctx context.Context, tx storage.Repository, res *Result) error {
+	if len(b.todos) == 0 {
+		return nil
+	}
+	ids := make([]string, len(b.todos))
+	for i, item := range b.todos {
+		ids[i] = item.Id
+	}
+	existing, err := tx.LockTodos(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	items := b.todos
+	if len(existing) > 0 {
+		switch b.policy {
+		case Fail:
+			return fmt.Errorf("todo %s: %s", existing[0].Id, storage.ErrAlreadyExists)
+		case Skip:
+			found := map[string]bool{}
+			for _, item := range existing {
+				found[item.Id] = true
+			}
+			items = nil
+			for _, item := range b.todos {
+				if found[item.Id] {
+					res.Skipped++
+				} else {
+					items = append(items, item)
+				}
+			}
+		case Overwrite:
+			found := make([]string, len(existing))
+			for i, item := range existing {
+				found[i] = item.Id
+			}
+			if _, err := tx.DeleteTodos(ctx, storage.Filter{IDs: found}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	if err := tx.CreateTodos(ctx, items...); err != nil {
+		return err
+	}
+	res.Todos += len(items)
+	for _, item := range items {
+		for _, entry := range b.entries[item.Id] {
+			if err := tx.CreateTimeEntry(ctx, entry); err != nil {
+				return fmt.Errorf("time entry %s: %s", entry.Id, err)
+			}
+			res.TimeEntries++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// Package snapshot writes and restores portable backups of the todos, their
+// time entries, the custom field schemas and the templates. A snapshot is
+// a gzip stream of a magic line, then a header and the records, each
+// encoded as a protobuf message prefixed with its length as a uvarint. It
+// does not depend on the storage driver nor on the version of its tools.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	// magic starts the snapshots once decompressed.
+	magic = "gotasks-snapshot\n"
+	// FormatVersion is the version of the snapshots written by Backup.
+	FormatVersion = 1
+	// maxMessageSize bounds the size of a header or record, so that a
+	// corrupted length does not exhaust the memory.
+	maxMessageSize = 64 << 20
+	// pageSize is the number of todos read per query by Backup.
+	pageSize = 500
+)
+
+// Backup writes a snapshot of repo to w, with the header fields set by the
+// caller, such as the schema version and the driver. The records are
+// spooled to a temporary file to compute the checksum of the header, the
+// todos are read page by page so that the service keeps serving them.
+func Backup(ctx context.Context, repo storage.Repository, w io.Writer, header todo.SnapshotHeader) (*todo.SnapshotHeader, error) {
+	spool, err := ioutil.TempFile("", "gotasks-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	sum := sha256.New()
+	body := bufio.NewWriter(io.MultiWriter(spool, sum))
+	var records int64
+	write := func(record *todo.SnapshotRecord) error {
+		records++
+		return writeMessage(body, record)
+	}
+	if err := backupRecords(ctx, repo, write); err != nil {
+		return nil, err
+	}
+	if err := body.Flush(); err != nil {
+		return nil, err
+	}
+
+	header.FormatVersion = FormatVersion
+	header.CreatedAt = types.TimestampNow()
+	header.Checksum = sum.Sum(nil)
+	header.Records = records
+	z := gzip.NewWriter(w)
+	if _, err := io.WriteString(z, magic); err != nil {
+		return nil, err
+	}
+	if err := writeMessage(z, &header); err != nil {
+		return nil, err
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(z, spool); err != nil {
+		return nil, err
+	}
+	return &header, z.Close()
+}
+
+// backupRecords calls write with the schemas, the templates, then each todo
+// followed by its time entries.
+func backupRecords(ctx context.Context, repo storage.Repository, write func(*todo.SnapshotRecord) error) error {
+	schemas, err := repo.ListCustomFieldSchemas(ctx)
+	if err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		if err := write(&todo.SnapshotRecord{Record: &todo.SnapshotRecord_CustomFieldSchema{CustomFieldSchema: schema}}); err != nil {
+			return err
+		}
+	}
+	templates, err := repo.ListTemplates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, template := range templates {
+		if err := write(&todo.SnapshotRecord{Record: &todo.SnapshotRecord_Template{Template: template}}); err != nil {
+			return err
+		}
+	}
+	var after string
+	for {
+		items, err := repo.ListTodos(ctx, storage.Filter{AfterID: after, Order: storage.Order{Field: "id"}, Limit: pageSize})
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			after = item.Id
+			if err := write(&todo.SnapshotRecord{Record: &todo.SnapshotRecord_Todo{Todo: item}}); err != nil {
+				return err
+			}
+			entries, err := repo.ListTimeEntries(ctx, item.Id)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				if err := write(&todo.SnapshotRecord{Record: &todo.SnapshotRecord_TimeEntry{TimeEntry: entry}}); err != nil {
+					return err
+				}
+			}
+		}
+		if len(items) < pageSize {
+			return nil
+		}
+	}
+}
+
+func writeMessage(w io.Writer, msg proto.Message) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(b)))
+	if _, err := w.Write(prefix[:n]); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// Reader reads the records of a snapshot.
+type Reader struct {
+	Header *todo.SnapshotHeader
+
+	r       *bufio.Reader
+	sum     hash.Hash
+	records int64
+}
+
+// NewReader reads the header of the snapshot of r.
+func NewReader(r io.Reader) (*Reader, error) {
+	z, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a snapshot: %s", err)
+	}
+	br := bufio.NewReader(z)
+	b := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, b); err != nil || string(b) != magic {
+		return nil, fmt.Errorf("not a snapshot")
+	}
+	var header todo.SnapshotHeader
+	if err := readMessage(br, &header); err != nil {
+		return nil, fmt.Errorf("invalid snapshot header: %s", err)
+	}
+	if header.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("snapshot format version %d is not supported, the latest is %d", header.FormatVersion, FormatVersion)
+	}
+	return &Reader{Header: &header, r: br, sum: sha256.New()}, nil
+}
+
+// Next returns the next record, or io.EOF after the last one once the
+// number of records and the checksum match the header.
+func (r *Reader) Next() (*todo.SnapshotRecord, error) {
+	var record todo.SnapshotRecord
+	err := readMessage(io.TeeReader(r.r, r.sum), &record)
+	if err == io.EOF {
+		if r.records != r.Header.Records {
+			return nil, fmt.Errorf("snapshot has %d records instead of %d", r.records, r.Header.Records)
+		}
+		if !bytes.Equal(r.sum.Sum(nil), r.Header.Checksum) {
+			return nil, fmt.Errorf("snapshot checksum mismatch")
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot record %d: %s", r.records+1, err)
+	}
+	r.records++
+	return &record, nil
+}
+
+// readMessage returns io.EOF when r ends before the message.
+func readMessage(r io.Reader, msg proto.Message) error {
+	size, err := binary.ReadUvarint(byteReader{r})
+	if err != nil {
+		return err
+	}
+	if size > maxMessageSize {
+		return fmt.Errorf("message of %d bytes exceeds %d", size, maxMessageSize)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return proto.Unmarshal(b, msg)
+}
+
+// byteReader reads the length prefixes through the checksum of a Reader.
+type byteReader struct {
+	io.Reader
+}
+
+func (r byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.Reader, b[:])
+	return b[0], err
+}
+
+// Verify reads every record of the snapshot of r and returns its header,
+// or an error when the snapshot is truncated or corrupted.
+func Verify(r io.Reader) (*todo.SnapshotHeader, error) {
+	sr, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, err := sr.Next(); err == io.EOF {
+			return sr.Header, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
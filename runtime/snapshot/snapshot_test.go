@@ -0,0 +1,156 @@
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/memory"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func seed(t *testing.T, repo storage.Repository) {
+	ctx := context.Background()
+	assert.Nil(t, repo.CreateTodos(ctx,
+		&todo.Todo{Id: "1", Title: "a", Tags: []string{"x"}, TrackedSeconds: 60},
+		&todo.Todo{Id: "2", Title: "b", ParentId: "1"},
+		&todo.Todo{Id: "3", Title: "c", CustomFields: map[string]string{"k": "v"}},
+	))
+	assert.Nil(t, repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e1", TodoId: "1", UserId: "u", StartedAt: types.TimestampNow(), StoppedAt: types.TimestampNow(), DurationSeconds: 60}))
+	assert.Nil(t, repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e2", TodoId: "3", UserId: "u", StartedAt: types.TimestampNow()}))
+	assert.Nil(t, repo.PutCustomFieldSchema(ctx, &todo.CustomFieldSchema{List: "work", Schema: `{"type": "object"}`}))
+	assert.Nil(t, repo.CreateTemplate(ctx, &todo.TodoTemplate{Id: "t", Name: "release"}))
+}
+
+func backup(t *testing.T, repo storage.Repository) []byte {
+	var buf bytes.Buffer
+	header, err := Backup(context.Background(), repo, &buf, todo.SnapshotHeader{Driver: "memory", SchemaVersion: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), header.Records)
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := memory.New()
+	seed(t, src)
+	b := backup(t, src)
+
+	header, err := Verify(bytes.NewReader(b))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(FormatVersion), header.FormatVersion)
+	assert.Equal(t, "memory", header.Driver)
+
+	dst := memory.New()
+	res, err := Restore(ctx, dst, bytes.NewReader(b), Options{OnConflict: Fail, BatchSize: 2, Driver: "memory", SchemaVersion: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, &Result{Todos: 3, TimeEntries: 2, Schemas: 1, Templates: 1}, res)
+
+	for _, id := range []string{"1", "2", "3"} {
+		want, _ := src.GetTodo(ctx, id)
+		got, err := dst.GetTodo(ctx, id)
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+		want2, _ := src.ListTimeEntries(ctx, id)
+		got2, _ := dst.ListTimeEntries(ctx, id)
+		assert.Equal(t, want2, got2)
+	}
+	schemas, _ := dst.ListCustomFieldSchemas(ctx)
+	assert.Len(t, schemas, 1)
+	_, err = dst.GetTemplate(ctx, "t")
+	assert.Nil(t, err)
+}
+
+func TestRestoreConflicts(t *testing.T) {
+	ctx := context.Background()
+	src := memory.New()
+	seed(t, src)
+	b := backup(t, src)
+	opts := Options{BatchSize: 100, Driver: "memory", SchemaVersion: 2}
+
+	dst := memory.New()
+	assert.Nil(t, dst.CreateTodos(ctx, &todo.Todo{Id: "3", Title: "local"}))
+
+	opts.OnConflict = Fail
+	_, err := Restore(ctx, dst, bytes.NewReader(b), opts)
+	assert.NotNil(t, err)
+	count, _ := dst.CountTodos(ctx, storage.Filter{})
+	assert.Equal(t, 1, count)
+
+	opts.OnConflict = Skip
+	res, err := Restore(ctx, dst, bytes.NewReader(b), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, res.Todos)
+	assert.Equal(t, 1, res.Skipped)
+	item, _ := dst.GetTodo(ctx, "3")
+	assert.Equal(t, "local", item.Title)
+
+	opts.OnConflict = Overwrite
+	res, err = Restore(ctx, dst, bytes.NewReader(b), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, &Result{Todos: 3, TimeEntries: 2, Schemas: 1, Templates: 1}, res)
+	item, _ = dst.GetTodo(ctx, "3")
+	assert.Equal(t, "c", item.Title)
+	entries, _ := dst.ListTimeEntries(ctx, "1")
+	assert.Len(t, entries, 1)
+
+	opts.OnConflict = "merge"
+	_, err = Restore(ctx, dst, bytes.NewReader(b), opts)
+	assert.NotNil(t, err)
+}
+
+func TestRestoreNewerSchema(t *testing.T) {
+	src := memory.New()
+	seed(t, src)
+	b := backup(t, src)
+	_, err := Restore(context.Background(), memory.New(), bytes.NewReader(b), Options{OnConflict: Fail, BatchSize: 1, Driver: "memory", SchemaVersion: 1})
+	assert.NotNil(t, err)
+	// Versions of other drivers are not comparable
+	_, err = Restore(context.Background(), memory.New(), bytes.NewReader(b), Options{OnConflict: Fail, BatchSize: 1, Driver: "sqlite", SchemaVersion: 1})
+	assert.Nil(t, err)
+}
+
+func TestVerifyCorrupted(t *testing.T) {
+	src := memory.New()
+	seed(t, src)
+	b := backup(t, src)
+
+	z, err := gzip.NewReader(bytes.NewReader(b))
+	assert.Nil(t, err)
+	raw, err := ioutil.ReadAll(z)
+	assert.Nil(t, err)
+	compress := func(raw []byte) []byte {
+		var buf bytes.Buffer
+		z := gzip.NewWriter(&buf)
+		z.Write(raw)
+		z.Close()
+		return buf.Bytes()
+	}
+
+	// A flipped byte of the last record fails the checksum
+	flipped := append([]byte(nil), raw...)
+	flipped[len(flipped)-2] ^= 0xff
+	_, err = Verify(bytes.NewReader(compress(flipped)))
+	assert.NotNil(t, err)
+
+	// A truncated snapshot misses records
+	_, err = Verify(bytes.NewReader(compress(raw[:len(raw)-1])))
+	assert.NotNil(t, err)
+
+	_, err = Verify(bytes.NewReader(compress([]byte("not a snapshot"))))
+	assert.NotNil(t, err)
+	_, err = Verify(bytes.NewReader([]byte("plain")))
+	assert.NotNil(t, err)
+
+	// Restore writes nothing from a corrupted snapshot
+	dst := memory.New()
+	_, err = Restore(context.Background(), dst, bytes.NewReader(compress(flipped)), Options{OnConflict: Fail, BatchSize: 1})
+	assert.NotNil(t, err)
+	count, _ := dst.CountTodos(context.Background(), storage.Filter{})
+	assert.Equal(t, 0, count)
+}
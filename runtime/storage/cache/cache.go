@@ -0,0 +1,396 @@
+// Package cache decorates a storage.Repository with an in-process read
+// through cache of the todo items, shared with the other instances of the
+// service through a Notifier invalidating their caches on every write.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	todoPrefix = "todo/"
+	listPrefix = "list/"
+	// maxPayload is the size of the largest notification, under the
+	// limit of the NOTIFY command of postgres. Larger invalidations
+	// invalidate every item.
+	maxPayload = 7900
+	// minBackoff and maxBackoff bound the wait before listening again
+	// to the notifications after a failure.
+	minBackoff = 100 * time.Millisecond
+	maxBackoff = 30 * time.Second
+)
+
+// Notifier broadcasts messages to the caches of the other instances of the
+// service. Messages may be lost, the TTL of the entries bounds how long a
+// cache misses an invalidation.
+type Notifier interface {
+	// Notify sends payload to every listener, including this instance.
+	Notify(ctx context.Context, payload string) error
+	// Listen calls fn with the payload of each message until ctx is done.
+	Listen(ctx context.Context, fn func(payload string)) error
+}
+
+// Options configures a cache.
+type Options struct {
+	// Size is the maximum number of cached entries, each item read by
+	// GetTodo and each result of ListTodos is an entry.
+	Size int
+	// TTL is how long an entry is served.
+	TTL time.Duration
+	// Notifier shares the invalidations with the other instances, when set.
+	Notifier Notifier
+	// OnError is called with the errors of the Notifier, when set.
+	OnError func(err error)
+}
+
+// Repository caches the results of GetTodo and ListTodos for the reads
+// allowed to be stale, see storage.AllowStale, and forwards the other
+// calls. Every write of todo items invalidates the cache. The cache is
+// filled from the primary database, a replica lagging behind a write would
+// otherwise cache the records the write invalidated.
+type Repository struct {
+	storage.Repository
+	c *cache
+}
+
+type cache struct {
+	mu  sync.Mutex
+	lru *lru
+	// gen changes on every invalidation, a value read from the repository
+	// is only cached when no invalidation happened during the read.
+	gen      uint64
+	origin   string
+	notifier Notifier
+	onError  func(err error)
+	backoff  time.Duration
+	now      func() time.Time
+
+	requests      *prometheus.CounterVec
+	evictions     prometheus.Counter
+	invalidations *prometheus.CounterVec
+	notifyErrors  prometheus.Counter
+	entries       *prometheus.Desc
+}
+
+// message is the payload of the notifications, IDs is nil when every item
+// may have changed.
+type message struct {
+	Origin string   `json:"origin"`
+	IDs    []string `json:"ids"`
+}
+
+// New returns repo decorated with a cache.
+func New(repo storage.Repository, opts Options) *Repository {
+	return &Repository{Repository: repo, c: &cache{
+		lru:      newLRU(opts.Size, opts.TTL),
+		origin:   uuid.NewV4().String(),
+		notifier: opts.Notifier,
+		onError:  opts.OnError,
+		backoff:  minBackoff,
+		now:      time.Now,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gotasks_cache_requests_total",
+			Help: "Number of the reads of the cache by method, GetTodo or ListTodos, and result, hit or miss.",
+		}, []string{"method", "result"}),
+		evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "gotasks_cache_evictions_total",
+			Help: "Number of the entries evicted from the cache to make room for new ones.",
+		}),
+		invalidations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gotasks_cache_invalidations_total",
+			Help: "Number of the invalidations of the cache by source, local, remote or reconnect.",
+		}, []string{"source"}),
+		notifyErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "gotasks_cache_notify_errors_total",
+			Help: "Number of the invalidations which could not be sent to the other instances.",
+		}),
+		entries: prometheus.NewDesc("gotasks_cache_entries", "Number of the entries of the cache.", nil, nil),
+	}}
+}
+
+// Listen invalidates the cache on the notifications of the other instances
+// until ctx is done. When the Notifier fails, Listen reports the error to
+// OnError and listens again after a backoff, flushing the cache as the
+// notifications sent in the meantime are lost. It returns at once without
+// a Notifier.
+func (r *Repository) Listen(ctx context.Context) error {
+	if r.c.notifier == nil {
+		return nil
+	}
+	backoff := r.c.backoff
+	for {
+		started := r.c.now()
+		err := r.c.notifier.Listen(ctx, func(payload string) {
+			var m message
+			if err := json.Unmarshal([]byte(payload), &m); err != nil || m.Origin == r.c.origin {
+				return
+			}
+			r.c.invalidate(m.IDs)
+			r.c.invalidations.WithLabelValues("remote").Inc()
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && r.c.onError != nil {
+			r.c.onError(err)
+		}
+		if r.c.now().Sub(started) > maxBackoff {
+			backoff = r.c.backoff
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		r.c.invalidate(nil)
+		r.c.invalidations.WithLabelValues("reconnect").Inc()
+	}
+}
+
+// get returns the cached value of key, or the generation to pass to put.
+func (c *cache) get(method, key string) (interface{}, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.lru.get(key, c.now())
+	result := "miss"
+	if ok {
+		result = "hit"
+	}
+	c.requests.WithLabelValues(method, result).Inc()
+	return v, c.gen, ok
+}
+
+func (c *cache) put(key string, gen uint64, v interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	c.evictions.Add(float64(c.lru.put(key, v, c.now())))
+}
+
+// invalidate removes the items of ids of every tenant, every item when ids
+// is nil, and every list.
+func (c *cache) invalidate(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	if ids == nil {
+		c.lru.deleteIf(func(string) bool { return true })
+		return
+	}
+	changed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		changed[id] = true
+	}
+	c.lru.deleteIf(func(key string) bool {
+		if strings.HasPrefix(key, listPrefix) {
+			return true
+		}
+		id := strings.TrimPrefix(key, todoPrefix)
+		if i := strings.IndexByte(id, 0); i >= 0 {
+			id = id[:i]
+		}
+		return changed[id]
+	})
+}
+
+// itemKey is the key of an item read by a tenant, the entries of the
+// tenants are kept apart.
+func itemKey(tenant, id string) string {
+	return todoPrefix + id + "\x00" + tenant
+}
+
+// changed invalidates the items of ids, every item when ids is nil, here
+// and in the other instances.
+func (c *cache) changed(ctx context.Context, ids []string) {
+	if ids != nil && len(ids) == 0 {
+		return
+	}
+	c.invalidate(ids)
+	c.invalidations.WithLabelValues("local").Inc()
+	if c.notifier == nil {
+		return
+	}
+	payload, _ := json.Marshal(message{Origin: c.origin, IDs: ids})
+	if len(payload) > maxPayload {
+		payload, _ = json.Marshal(message{Origin: c.origin})
+	}
+	if err := c.notifier.Notify(ctx, string(payload)); err != nil {
+		c.notifyErrors.Inc()
+	}
+}
+
+// GetTodo returns the cached item when the read may be stale.
+func (r *Repository) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	if !storage.StaleAllowed(ctx) {
+		return r.Repository.GetTodo(ctx, id)
+	}
+	key := itemKey(storage.Tenant(ctx), id)
+	v, gen, ok := r.c.get("GetTodo", key)
+	if ok {
+		return proto.Clone(v.(*todo.Todo)).(*todo.Todo), nil
+	}
+	item, err := r.Repository.GetTodo(storage.Fresh(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	r.c.put(key, gen, proto.Clone(item))
+	return item, nil
+}
+
+// ListTodos returns the cached items when the read may be stale.
+func (r *Repository) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	if !storage.StaleAllowed(ctx) {
+		return r.Repository.ListTodos(ctx, f)
+	}
+	// %#v tells nil and empty slices apart, they select different items
+	key := listPrefix + fmt.Sprintf("%q %#v", storage.Tenant(ctx), f)
+	v, gen, ok := r.c.get("ListTodos", key)
+	if ok {
+		return clone(v.([]*todo.Todo)), nil
+	}
+	items, err := r.Repository.ListTodos(storage.Fresh(ctx), f)
+	if err != nil {
+		return nil, err
+	}
+	r.c.put(key, gen, clone(items))
+	return items, nil
+}
+
+func clone(items []*todo.Todo) []*todo.Todo {
+	if items == nil {
+		return nil
+	}
+	c := make([]*todo.Todo, len(items))
+	for i, item := range items {
+		c[i] = proto.Clone(item).(*todo.Todo)
+	}
+	return c
+}
+
+// Transaction runs fn with a repository recording the changed items, the
+// cache is invalidated when fn returns.
+func (r *Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	t := &tx{}
+	err := r.Repository.Transaction(ctx, func(repo storage.Repository) error {
+		t.Repository = repo
+		return fn(t)
+	})
+	if t.all {
+		r.c.changed(ctx, nil)
+	} else if len(t.ids) > 0 {
+		r.c.changed(ctx, t.ids)
+	}
+	return err
+}
+
+// CreateTodos invalidates the lists.
+func (r *Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	err := r.Repository.CreateTodos(ctx, items...)
+	r.c.changed(ctx, ids(items))
+	return err
+}
+
+// UpdateTodos invalidates the items and the lists.
+func (r *Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	err := r.Repository.UpdateTodos(ctx, items...)
+	r.c.changed(ctx, ids(items))
+	return err
+}
+
+// AddTrackedSeconds invalidates the item and the lists.
+func (r *Repository) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	err := r.Repository.AddTrackedSeconds(ctx, id, seconds)
+	r.c.changed(ctx, []string{id})
+	return err
+}
+
+// DeleteTodos invalidates the items of f.IDs, or every item, and the lists.
+func (r *Repository) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	n, err := r.Repository.DeleteTodos(ctx, f)
+	r.c.changed(ctx, f.IDs)
+	return n, err
+}
+
+func ids(items []*todo.Todo) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.Id
+	}
+	return ids
+}
+
+// tx is the repository of a transaction, it reads and writes through and
+// records the items changed by the writes.
+type tx struct {
+	storage.Repository
+	ids []string
+	all bool
+}
+
+func (t *tx) record(ids []string) {
+	if ids == nil {
+		t.all = true
+	}
+	t.ids = append(t.ids, ids...)
+}
+
+func (t *tx) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	return fn(t)
+}
+
+func (t *tx) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	t.record(ids(items))
+	return t.Repository.CreateTodos(ctx, items...)
+}
+
+func (t *tx) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	t.record(ids(items))
+	return t.Repository.UpdateTodos(ctx, items...)
+}
+
+func (t *tx) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	t.record([]string{id})
+	return t.Repository.AddTrackedSeconds(ctx, id, seconds)
+}
+
+func (t *tx) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	t.record(f.IDs)
+	return t.Repository.DeleteTodos(ctx, f)
+}
+
+// Describe implements the prometheus Collector interface.
+func (r *Repository) Describe(ch chan<- *prometheus.Desc) {
+	r.c.requests.Describe(ch)
+	r.c.evictions.Describe(ch)
+	r.c.invalidations.Describe(ch)
+	r.c.notifyErrors.Describe(ch)
+	ch <- r.c.entries
+}
+
+// Collect implements the prometheus Collector interface.
+func (r *Repository) Collect(ch chan<- prometheus.Metric) {
+	r.c.requests.Collect(ch)
+	r.c.evictions.Collect(ch)
+	r.c.invalidations.Collect(ch)
+	r.c.notifyErrors.Collect(ch)
+	r.c.mu.Lock()
+	n := r.c.lru.len()
+	r.c.mu.Unlock()
+	ch <- prometheus.MustNewConstMetric(r.c.entries, prometheus.GaugeValue, float64(n))
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/memory"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// bus delivers the notifications synchronously to every listener. Its
+// listeners fail at once while fails is positive.
+type bus struct {
+	mu        sync.Mutex
+	listeners []func(string)
+	fails     int
+	// listening receives a value when a listener is registered.
+	listening chan struct{}
+}
+
+func newBus() *bus {
+	return &bus{listening: make(chan struct{}, 10)}
+}
+
+func (b *bus) Notify(ctx context.Context, payload string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, fn := range b.listeners {
+		fn(payload)
+	}
+	return nil
+}
+
+func (b *bus) Listen(ctx context.Context, fn func(payload string)) error {
+	b.mu.Lock()
+	if b.fails > 0 {
+		b.fails--
+		b.mu.Unlock()
+		return errors.New("connection lost")
+	}
+	b.listeners = append(b.listeners, fn)
+	b.mu.Unlock()
+	b.listening <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// primary records whether the reads of the repository allow stale records.
+type primary struct {
+	storage.Repository
+	stale []bool
+}
+
+func (p *primary) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	p.stale = append(p.stale, storage.StaleAllowed(ctx))
+	return p.Repository.GetTodo(ctx, id)
+}
+
+func (p *primary) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	p.stale = append(p.stale, storage.StaleAllowed(ctx))
+	return p.Repository.ListTodos(ctx, f)
+}
+
+func TestCache(t *testing.T) {
+	backend := memory.New()
+	r := New(backend, Options{Size: 2, TTL: time.Minute})
+	now := time.Now()
+	r.c.now = func() time.Time { return now }
+	ctx := context.Background()
+	stale := storage.AllowStale(ctx)
+	assert.Nil(t, r.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "a"}, &todo.Todo{Id: "2"}, &todo.Todo{Id: "3"}))
+
+	item, err := r.GetTodo(stale, "1")
+	assert.Nil(t, err)
+	item.Title = "modified"
+	// Writes to the backend bypassing the cache are not seen until the TTL
+	assert.Nil(t, backend.UpdateTodos(ctx, &todo.Todo{Id: "1", Title: "b"}))
+	item, _ = r.GetTodo(stale, "1")
+	assert.Equal(t, "a", item.Title)
+	item, _ = r.GetTodo(ctx, "1")
+	assert.Equal(t, "b", item.Title)
+	now = now.Add(time.Minute)
+	item, _ = r.GetTodo(stale, "1")
+	assert.Equal(t, "b", item.Title)
+	assert.Equal(t, 1.0, testutil.ToFloat64(r.c.requests.WithLabelValues("GetTodo", "hit")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(r.c.requests.WithLabelValues("GetTodo", "miss")))
+
+	// Writes through the cache invalidate it
+	items, _ := r.ListTodos(stale, storage.Filter{})
+	assert.Len(t, items, 3)
+	assert.Nil(t, r.UpdateTodos(ctx, &todo.Todo{Id: "1", Title: "c"}))
+	item, _ = r.GetTodo(stale, "1")
+	assert.Equal(t, "c", item.Title)
+	_, err = r.DeleteTodos(ctx, storage.Filter{IDs: []string{"2"}})
+	assert.Nil(t, err)
+	items, _ = r.ListTodos(stale, storage.Filter{})
+	assert.Len(t, items, 2)
+	assert.Nil(t, r.Transaction(ctx, func(tx storage.Repository) error {
+		return tx.AddTrackedSeconds(ctx, "1", 60)
+	}))
+	item, _ = r.GetTodo(stale, "1")
+	assert.Equal(t, int64(60), item.TrackedSeconds)
+
+	// The least recently used entries are evicted
+	r.GetTodo(stale, "3")
+	r.GetTodo(stale, "1")
+	r.ListTodos(stale, storage.Filter{})
+	assert.Equal(t, 2, r.c.lru.len())
+	assert.NotContains(t, r.c.lru.items, itemKey("", "3"))
+	assert.Equal(t, 1.0, testutil.ToFloat64(r.c.evictions))
+}
+
+// TestCacheFill fills the cache from the primary database, as a replica may
+// still serve the records a write just invalidated.
+func TestCacheFill(t *testing.T) {
+	ctx := context.Background()
+	stale := storage.AllowStale(ctx)
+	backend := &primary{Repository: memory.New()}
+	r := New(backend, Options{Size: 10, TTL: time.Minute})
+	assert.Nil(t, r.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "a"}))
+	r.GetTodo(stale, "1")
+	r.ListTodos(stale, storage.Filter{})
+	assert.Equal(t, []bool{false, false}, backend.stale)
+}
+
+func TestCacheNotifications(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stale := storage.AllowStale(ctx)
+	backend, b := memory.New(), newBus()
+	r1 := New(backend, Options{Size: 10, TTL: time.Minute, Notifier: b})
+	r2 := New(backend, Options{Size: 10, TTL: time.Minute, Notifier: b})
+	go r1.Listen(ctx)
+	go r2.Listen(ctx)
+	<-b.listening
+	<-b.listening
+	assert.Nil(t, r1.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "a"}))
+
+	r2.GetTodo(stale, "1")
+	r2.ListTodos(stale, storage.Filter{})
+	assert.Nil(t, r1.UpdateTodos(ctx, &todo.Todo{Id: "1", Title: "b"}))
+	item, _ := r2.GetTodo(stale, "1")
+	assert.Equal(t, "b", item.Title)
+	r2.GetTodo(stale, "1")
+	_, err := r1.DeleteTodos(ctx, storage.Filter{OnlyCompleted: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r2.c.lru.len())
+	assert.Equal(t, 3.0, testutil.ToFloat64(r2.c.invalidations.WithLabelValues("remote")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(r1.c.invalidations.WithLabelValues("remote")))
+}
+
+// TestCacheReconnect listens again after a failure of the notifier and
+// flushes the cache, which may have missed invalidations.
+func TestCacheReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stale := storage.AllowStale(ctx)
+	b := newBus()
+	b.fails = 2
+	var errs int
+	r := New(memory.New(), Options{Size: 10, TTL: time.Minute, Notifier: b, OnError: func(error) { errs++ }})
+	r.c.backoff = time.Millisecond
+	assert.Nil(t, r.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "a"}))
+	r.GetTodo(stale, "1")
+	assert.Equal(t, 1, r.c.lru.len())
+
+	done := make(chan error)
+	go func() { done <- r.Listen(ctx) }()
+	<-b.listening
+	assert.Equal(t, 2, errs)
+	assert.Equal(t, 0, r.c.lru.len())
+	assert.Equal(t, 2.0, testutil.ToFloat64(r.c.invalidations.WithLabelValues("reconnect")))
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
+
+func TestCacheTenants(t *testing.T) {
+	ctx := context.Background()
+	r := New(memory.New(), Options{Size: 10, TTL: time.Minute})
+	assert.Nil(t, r.CreateTodos(ctx, &todo.Todo{Id: "1"}))
+	a := storage.AllowStale(storage.WithTenant(ctx, "a"))
+	b := storage.AllowStale(storage.WithTenant(ctx, "b"))
+
+	// The entries of a tenant are not served to the others
+	r.GetTodo(a, "1")
+	r.GetTodo(b, "1")
+	r.ListTodos(a, storage.Filter{})
+	r.ListTodos(b, storage.Filter{})
+	assert.Equal(t, 0.0, testutil.ToFloat64(r.c.requests.WithLabelValues("GetTodo", "hit")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(r.c.requests.WithLabelValues("ListTodos", "hit")))
+	assert.Equal(t, 4, r.c.lru.len())
+
+	// A change invalidates the item for every tenant
+	assert.Nil(t, r.UpdateTodos(storage.WithTenant(ctx, "a"), &todo.Todo{Id: "1", Title: "b"}))
+	assert.Equal(t, 0, r.c.lru.len())
+}
+
+func TestConformance(t *testing.T) {
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository {
+			return New(memory.New(), Options{Size: 100, TTL: time.Minute})
+		},
+	})
+}
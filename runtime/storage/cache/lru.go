@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// lru is a size bounded least recently used cache whose entries expire
+// after a TTL. It is not safe for concurrent use.
+type lru struct {
+	size  int
+	ttl   time.Duration
+	items map[string]*list.Element
+	// order holds the entries from the most to the least recently used.
+	order *list.List
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+func newLRU(size int, ttl time.Duration) *lru {
+	return &lru{size: size, ttl: ttl, items: map[string]*list.Element{}, order: list.New()}
+}
+
+// get returns the value of key unless it is missing or expired.
+func (c *lru) get(key string, now time.Time) (interface{}, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !now.Before(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// put stores value under key and returns the number of entries evicted
+// to make room for it.
+func (c *lru) put(key string, value interface{}, now time.Time) int {
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expires = value, now.Add(c.ttl)
+		c.order.MoveToFront(el)
+		return 0
+	}
+	c.items[key] = c.order.PushFront(&entry{key: key, value: value, expires: now.Add(c.ttl)})
+	evicted := 0
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		evicted++
+	}
+	return evicted
+}
+
+// delete removes the entries of keys.
+func (c *lru) delete(keys ...string) {
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// deleteIf removes the entries whose key matches.
+func (c *lru) deleteIf(match func(key string) bool) {
+	for key, el := range c.items {
+		if match(key) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *lru) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
+
+func (c *lru) len() int {
+	return c.order.Len()
+}
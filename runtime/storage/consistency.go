@@ -0,0 +1,27 @@
+package storage
+
+import "context"
+
+// ConsistencyStrong is the consistency of the read requests which must see
+// every committed write, they are served by the primary database.
+const ConsistencyStrong = "strong"
+
+type staleKey struct{}
+
+// AllowStale marks the reads done with ctx as allowed to return records
+// which are not up to date, so that they may be served by a replica.
+func AllowStale(ctx context.Context) context.Context {
+	return context.WithValue(ctx, staleKey{}, true)
+}
+
+// StaleAllowed tells whether the reads done with ctx may be served by a replica.
+func StaleAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(staleKey{}).(bool)
+	return allowed
+}
+
+// Fresh returns ctx with its reads served by the primary database, even
+// when ctx allows stale reads.
+func Fresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, staleKey{}, false)
+}
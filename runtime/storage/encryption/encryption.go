@@ -0,0 +1,308 @@
+// Package encryption decorates a storage.Repository with the envelope
+// encryption of fields of the todo items. Each write encrypts the fields of
+// an item with AES-GCM under a new data key, which is stored with the field
+// wrapped by the primary master key of a Keyring, along with its key ID.
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+)
+
+// prefix starts the encrypted values, followed by the key ID, the wrapped
+// data key and the ciphertext separated by colons.
+const prefix = "enc:v1:"
+
+// Fields are the fields of the todos which may be encrypted. The items can
+// not be sorted by an encrypted field.
+var Fields = []string{"title", "description"}
+
+// Repository encrypts the fields of the todos it writes, in the outbox
+// too, and decrypts those it reads. Values stored in clear are read as is,
+// until Rotate encrypts them.
+type Repository struct {
+	storage.Repository
+	keys   *Keyring
+	fields []string
+}
+
+// New returns repo encrypting fields, among Fields, with keys.
+func New(repo storage.Repository, keys *Keyring, fields ...string) (*Repository, error) {
+	for _, f := range fields {
+		if field(&todo.Todo{}, f) == nil {
+			return nil, fmt.Errorf("field %q can not be encrypted, only %s can", f, strings.Join(Fields, " and "))
+		}
+	}
+	return &Repository{Repository: repo, keys: keys, fields: fields}, nil
+}
+
+func field(item *todo.Todo, name string) *string {
+	switch name {
+	case "title":
+		return &item.Title
+	case "description":
+		return &item.Description
+	}
+	return nil
+}
+
+// data binds a ciphertext to its item and field, so that it can not be
+// moved to another one.
+func data(item *todo.Todo, name string) []byte {
+	return []byte(item.Id + "/" + name)
+}
+
+// encrypt replaces the fields of items with their encryption, restore puts
+// back the values in clear.
+func (r *Repository) encrypt(items []*todo.Todo) (restore func(), err error) {
+	type value struct {
+		p     *string
+		clear string
+	}
+	var values []value
+	restore = func() {
+		for _, v := range values {
+			*v.p = v.clear
+		}
+	}
+	for _, item := range items {
+		key := make([]byte, keySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			restore()
+			return nil, err
+		}
+		wrapped, err := r.keys.wrap(key)
+		if err != nil {
+			restore()
+			return nil, err
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			restore()
+			return nil, err
+		}
+		for _, name := range r.fields {
+			p := field(item, name)
+			if *p == "" {
+				continue
+			}
+			sealed, err := seal(aead, []byte(*p), data(item, name))
+			if err != nil {
+				restore()
+				return nil, err
+			}
+			values = append(values, value{p, *p})
+			*p = prefix + r.keys.primary + ":" + base64.RawStdEncoding.EncodeToString(wrapped) + ":" + base64.RawStdEncoding.EncodeToString(sealed)
+		}
+	}
+	return restore, nil
+}
+
+// decrypt replaces the encrypted fields of items with their values in clear.
+func (r *Repository) decrypt(items ...*todo.Todo) error {
+	for _, item := range items {
+		for _, name := range r.fields {
+			p := field(item, name)
+			if !strings.HasPrefix(*p, prefix) {
+				continue
+			}
+			clear, err := r.open(item, name, *p)
+			if err != nil {
+				return fmt.Errorf("encryption: could not decrypt the %s of %s: %s", name, item.Id, err)
+			}
+			*p = clear
+		}
+	}
+	return nil
+}
+
+func (r *Repository) open(item *todo.Todo, name, v string) (string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(v, prefix), ":", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed value")
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", err
+	}
+	key, err := r.keys.unwrap(parts[0], wrapped)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	clear, err := open(aead, sealed, data(item, name))
+	return string(clear), err
+}
+
+// stale tells whether an item has fields stored in clear or wrapped by
+// another key than the primary one.
+func (r *Repository) stale(item *todo.Todo) bool {
+	for _, name := range r.fields {
+		p := field(item, name)
+		if *p != "" && !strings.HasPrefix(*p, prefix+r.keys.primary+":") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Repository) with(repo storage.Repository) *Repository {
+	return &Repository{Repository: repo, keys: r.keys, fields: r.fields}
+}
+
+// Transaction runs fn with a repository encrypting the fields in the
+// transaction.
+func (r *Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	return r.Repository.Transaction(ctx, func(repo storage.Repository) error {
+		return fn(r.with(repo))
+	})
+}
+
+// CreateTodos inserts items with their fields encrypted.
+func (r *Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	restore, err := r.encrypt(items)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return r.Repository.CreateTodos(ctx, items...)
+}
+
+// UpdateTodos updates items with their fields encrypted.
+func (r *Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	restore, err := r.encrypt(items)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return r.Repository.UpdateTodos(ctx, items...)
+}
+
+// GetTodo returns the item with its fields decrypted.
+func (r *Repository) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	item, err := r.Repository.GetTodo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return item, r.decrypt(item)
+}
+
+// LockTodos returns the items with their fields decrypted.
+func (r *Repository) LockTodos(ctx context.Context, ids ...string) ([]*todo.Todo, error) {
+	items, err := r.Repository.LockTodos(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	return items, r.decrypt(items...)
+}
+
+// ListTodos returns the items with their fields decrypted.
+func (r *Repository) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	items, err := r.Repository.ListTodos(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return items, r.decrypt(items...)
+}
+
+// AppendEvents inserts events with the fields of their items encrypted.
+func (r *Repository) AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error {
+	var items []*todo.Todo
+	for _, event := range events {
+		if event.Item != nil {
+			items = append(items, event.Item)
+		}
+	}
+	restore, err := r.encrypt(items)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return r.Repository.AppendEvents(ctx, events...)
+}
+
+// ClaimEvents returns events with the fields of their items decrypted.
+func (r *Repository) ClaimEvents(ctx context.Context, limit int) ([]*todo.TodoEvent, error) {
+	events, err := r.Repository.ClaimEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if event.Item != nil {
+			if err := r.decrypt(event.Item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return events, nil
+}
+
+// Rotate encrypts again, under data keys wrapped by the primary key, the
+// fields of the items stored in clear or wrapped by another key. It
+// rotates batchSize items per transaction, so that the service keeps
+// serving the items during the rotation, and returns the number of
+// rotated items.
+func (r *Repository) Rotate(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("invalid batch size %d", batchSize)
+	}
+	var rotated int
+	var after string
+	for {
+		var listed, done int
+		err := r.Repository.Transaction(ctx, func(tx storage.Repository) error {
+			items, err := tx.ListTodos(ctx, storage.Filter{AfterID: after, Order: storage.Order{Field: "id"}, Limit: batchSize})
+			if err != nil {
+				return err
+			}
+			listed = len(items)
+			var ids []string
+			for _, item := range items {
+				after = item.Id
+				if r.stale(item) {
+					ids = append(ids, item.Id)
+				}
+			}
+			if len(ids) == 0 {
+				return nil
+			}
+			// Lock the items so that no write is lost, then read them again
+			locked, err := tx.LockTodos(ctx, ids...)
+			if err != nil {
+				return err
+			}
+			var stale []*todo.Todo
+			for _, item := range locked {
+				if r.stale(item) {
+					stale = append(stale, item)
+				}
+			}
+			if err := r.decrypt(stale...); err != nil {
+				return err
+			}
+			done = len(stale)
+			return r.with(tx).UpdateTodos(ctx, stale...)
+		})
+		if err != nil {
+			return rotated, err
+		}
+		rotated += done
+		if listed < batchSize {
+			return rotated, nil
+		}
+	}
+}
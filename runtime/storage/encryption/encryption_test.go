@@ -0,0 +1,139 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/memory"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func newKey() []byte {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	return key
+}
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+	k1, k2 := newKey(), newKey()
+	keys, err := NewKeyring("k1", map[string][]byte{"k1": k1})
+	assert.Nil(t, err)
+	backend := memory.New()
+	r, err := New(backend, keys, "description")
+	assert.Nil(t, err)
+
+	item := &todo.Todo{Id: "1", Title: "call", Description: "+33 6 12 34 56 78"}
+	assert.Nil(t, r.CreateTodos(ctx, item, &todo.Todo{Id: "2", Description: "secret"}))
+	assert.Equal(t, "+33 6 12 34 56 78", item.Description)
+	raw, _ := backend.GetTodo(ctx, "1")
+	assert.True(t, strings.HasPrefix(raw.Description, "enc:v1:k1:"))
+	assert.Equal(t, "call", raw.Title)
+
+	// Reads decrypt the fields, values in clear are read as is
+	assert.Nil(t, backend.CreateTodos(ctx, &todo.Todo{Id: "3", Description: "legacy"}))
+	got, err := r.GetTodo(ctx, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "+33 6 12 34 56 78", got.Description)
+	items, err := r.ListTodos(ctx, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"+33 6 12 34 56 78", "secret", "legacy"}, descriptions(items))
+	assert.Nil(t, r.Transaction(ctx, func(tx storage.Repository) error {
+		items, err := tx.LockTodos(ctx, "2")
+		assert.Nil(t, err)
+		items[0].Description = "changed"
+		return tx.UpdateTodos(ctx, items...)
+	}))
+	got, _ = r.GetTodo(ctx, "2")
+	assert.Equal(t, "changed", got.Description)
+
+	// A ciphertext moved to another item can not be decrypted
+	raw2, _ := backend.GetTodo(ctx, "2")
+	raw2.Description = raw.Description
+	assert.Nil(t, backend.UpdateTodos(ctx, raw2))
+	_, err = r.GetTodo(ctx, "2")
+	assert.NotNil(t, err)
+	assert.Nil(t, r.UpdateTodos(ctx, &todo.Todo{Id: "2", Description: "changed"}))
+
+	// The items of the events are encrypted too
+	assert.Nil(t, r.AppendEvents(ctx, &todo.TodoEvent{TodoId: "1", Item: item}))
+	assert.Equal(t, "+33 6 12 34 56 78", item.Description)
+	rawEvents, _ := backend.ClaimEvents(ctx, 10)
+	assert.True(t, strings.HasPrefix(rawEvents[0].Item.Description, "enc:v1:k1:"))
+	events, err := r.ClaimEvents(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, "+33 6 12 34 56 78", events[0].Item.Description)
+
+	// Rotation encrypts every item under the new primary key
+	keys, err = NewKeyring("k2", map[string][]byte{"k1": k1, "k2": k2})
+	assert.Nil(t, err)
+	r, _ = New(backend, keys, "description")
+	rotated, err := r.Rotate(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rotated)
+	rotated, err = r.Rotate(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rotated)
+	keys, _ = NewKeyring("k2", map[string][]byte{"k2": k2})
+	r, _ = New(backend, keys, "description")
+	items, err = r.ListTodos(ctx, storage.Filter{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"+33 6 12 34 56 78", "changed", "legacy"}, descriptions(items))
+
+	_, err = New(backend, keys, "status")
+	assert.NotNil(t, err)
+}
+
+func descriptions(items []*todo.Todo) []string {
+	var res []string
+	for _, item := range items {
+		res = append(res, item.Description)
+	}
+	return res
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotasks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.json")
+	key := base64.StdEncoding.EncodeToString(newKey())
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"primary": "k1", "keys": {"k1": "`+key+`"}}`), 0600))
+	keys, err := LoadKeyring(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", keys.Primary())
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"primary": "k2", "keys": {"k1": "`+key+`"}}`), 0600))
+	_, err = LoadKeyring(path)
+	assert.NotNil(t, err)
+	_, err = NewKeyring("k1", map[string][]byte{"k1": []byte("short")})
+	assert.NotNil(t, err)
+	_, err = NewKeyring("a:b", map[string][]byte{"a:b": newKey()})
+	assert.NotNil(t, err)
+}
+
+// TestConformance runs the suite with the descriptions encrypted, the
+// items can not be sorted by an encrypted title.
+func TestConformance(t *testing.T) {
+	keys, err := NewKeyring("k1", map[string][]byte{"k1": newKey()})
+	assert.Nil(t, err)
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository {
+			repo, err := New(memory.New(), keys, "description")
+			assert.Nil(t, err)
+			return repo
+		},
+	})
+}
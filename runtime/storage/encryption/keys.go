@@ -0,0 +1,120 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// keySize is the size of the master and data keys, AES-256.
+const keySize = 32
+
+// Keyring holds the master keys wrapping the data keys, by ID. The data
+// keys are wrapped with the primary key, the others only unwrap the data
+// keys of the rows not rotated yet.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// keyFile is the format of the key files:
+//
+//	{"primary": "2019-02", "keys": {"2019-01": "<base64>", "2019-02": "<base64>"}}
+//
+// where each key is 32 random bytes encoded in base64.
+type keyFile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// LoadKeyring reads the master keys of a key file.
+func LoadKeyring(path string) (*Keyring, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f keyFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %s", path, err)
+	}
+	keys := make(map[string][]byte, len(f.Keys))
+	for id, key := range f.Keys {
+		if keys[id], err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, fmt.Errorf("invalid key %q in %s: %s", id, path, err)
+		}
+	}
+	return NewKeyring(f.Primary, keys)
+}
+
+// NewKeyring returns a keyring of 32 bytes master keys by ID, wrapping the
+// data keys with the primary one. IDs can not contain colons.
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("missing primary key %q", primary)
+	}
+	k := &Keyring{primary: primary, keys: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key %q has %d bytes instead of %d", id, len(key), keySize)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = aead
+	}
+	return k, nil
+}
+
+// Primary returns the ID of the key wrapping the new data keys.
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// wrap encrypts a data key with the primary key.
+func (k *Keyring) wrap(key []byte) ([]byte, error) {
+	return seal(k.keys[k.primary], key, []byte(k.primary))
+}
+
+// unwrap decrypts a data key wrapped by the key of keyID.
+func (k *Keyring) unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, prepended to the result.
+func seal(aead cipher.AEAD, plaintext, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, data), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, data []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce := ciphertext[:aead.NonceSize()]
+	return aead.Open(nil, nonce, ciphertext[aead.NonceSize():], data)
+}
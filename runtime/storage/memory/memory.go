@@ -0,0 +1,804 @@
+// Package memory stores the records of the todo service in memory, for
+// tests and for embedding the service without a database.
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// Repository is a storage.Repository keeping its records in maps. It is safe
+// for concurrent use, transactions are serialized.
+type Repository struct {
+	mu sync.RWMutex
+	s  *state
+}
+
+// state holds the records of a repository. Stored records are never
+// modified, updates replace them, so that copying the maps is enough
+// to snapshot the state.
+type state struct {
+	todos     map[string]*todo.Todo
+	entries   map[string]*todo.TimeEntry
+	schemas   map[string]*todo.CustomFieldSchema
+	templates map[string]*todo.TodoTemplate
+	events    map[int64]*todo.TodoEvent
+	// lastEvent is the ID of the last appended event.
+	lastEvent int64
+	// seq keeps the insertion order of the todo items, which breaks ties when sorting them.
+	seq  map[string]int
+	next int
+}
+
+// New returns an empty repository.
+func New() *Repository {
+	return &Repository{s: &state{
+		todos:     map[string]*todo.Todo{},
+		entries:   map[string]*todo.TimeEntry{},
+		schemas:   map[string]*todo.CustomFieldSchema{},
+		templates: map[string]*todo.TodoTemplate{},
+		events:    map[int64]*todo.TodoEvent{},
+		seq:       map[string]int{},
+	}}
+}
+
+func (s *state) snapshot() *state {
+	c := &state{
+		todos:     make(map[string]*todo.Todo, len(s.todos)),
+		entries:   make(map[string]*todo.TimeEntry, len(s.entries)),
+		schemas:   make(map[string]*todo.CustomFieldSchema, len(s.schemas)),
+		templates: make(map[string]*todo.TodoTemplate, len(s.templates)),
+		events:    make(map[int64]*todo.TodoEvent, len(s.events)),
+		lastEvent: s.lastEvent,
+		seq:       make(map[string]int, len(s.seq)),
+		next:      s.next,
+	}
+	for k, v := range s.todos {
+		c.todos[k] = v
+	}
+	for k, v := range s.entries {
+		c.entries[k] = v
+	}
+	for k, v := range s.schemas {
+		c.schemas[k] = v
+	}
+	for k, v := range s.templates {
+		c.templates[k] = v
+	}
+	for k, v := range s.events {
+		c.events[k] = v
+	}
+	for k, v := range s.seq {
+		c.seq[k] = v
+	}
+	return c
+}
+
+// Transaction runs fn holding the lock of the repository and restores
+// the records as they were when fn fails.
+func (r *Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.s.transaction(fn)
+}
+
+func (s *state) transaction(fn func(storage.Repository) error) error {
+	saved := s.snapshot()
+	if err := fn(&tx{s: s}); err != nil {
+		*s = *saved
+		return err
+	}
+	return nil
+}
+
+// read runs fn on the state under a read lock.
+func (r *Repository) read(ctx context.Context, fn func(*state) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return fn(r.s)
+}
+
+// write runs fn on the state under the write lock. The state methods check
+// their arguments before modifying anything, so that they are atomic.
+func (r *Repository) write(ctx context.Context, fn func(*state) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return fn(r.s)
+}
+
+// CreateTodos inserts items.
+func (r *Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.write(ctx, func(s *state) error { return s.createTodos(items) })
+}
+
+// GetTodo returns the item with the given ID.
+func (r *Repository) GetTodo(ctx context.Context, id string) (item *todo.Todo, err error) {
+	err = r.read(ctx, func(s *state) error {
+		item, err = s.getTodo(id)
+		return err
+	})
+	return item, err
+}
+
+// LockTodos returns the given items, the whole repository is locked
+// during a transaction.
+func (r *Repository) LockTodos(ctx context.Context, ids ...string) (items []*todo.Todo, err error) {
+	err = r.read(ctx, func(s *state) error {
+		items = s.lockTodos(ids)
+		return nil
+	})
+	return items, err
+}
+
+// ListTodos returns the items matching a filter.
+func (r *Repository) ListTodos(ctx context.Context, f storage.Filter) (items []*todo.Todo, err error) {
+	err = r.read(ctx, func(s *state) error {
+		items = s.listTodos(f)
+		return nil
+	})
+	return items, err
+}
+
+// CountTodos counts the items matching a filter.
+func (r *Repository) CountTodos(ctx context.Context, f storage.Filter) (count int, err error) {
+	err = r.read(ctx, func(s *state) error {
+		count = len(s.match(f))
+		return nil
+	})
+	return count, err
+}
+
+// UpdateTodos updates the mutable fields of items.
+func (r *Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.write(ctx, func(s *state) error {
+		s.updateTodos(items)
+		return nil
+	})
+}
+
+// AddTrackedSeconds increments the tracked seconds of an item.
+func (r *Repository) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	return r.write(ctx, func(s *state) error {
+		s.addTrackedSeconds(id, seconds)
+		return nil
+	})
+}
+
+// DeleteTodos deletes the items matching a filter and their time entries.
+func (r *Repository) DeleteTodos(ctx context.Context, f storage.Filter) (deleted int, err error) {
+	err = r.write(ctx, func(s *state) error {
+		deleted = s.deleteTodos(f)
+		return nil
+	})
+	return deleted, err
+}
+
+// CreateTimeEntry inserts a time entry.
+func (r *Repository) CreateTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	return r.write(ctx, func(s *state) error { return s.createTimeEntry(entry) })
+}
+
+// RunningTimeEntry returns the running entry of a user.
+func (r *Repository) RunningTimeEntry(ctx context.Context, userID string) (entry *todo.TimeEntry, err error) {
+	err = r.read(ctx, func(s *state) error {
+		entry, err = s.runningTimeEntry(userID)
+		return err
+	})
+	return entry, err
+}
+
+// StopTimeEntry updates the stop date and duration of an entry.
+func (r *Repository) StopTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	return r.write(ctx, func(s *state) error {
+		s.stopTimeEntry(entry)
+		return nil
+	})
+}
+
+// ListTimeEntries returns the entries of an item sorted by start date.
+func (r *Repository) ListTimeEntries(ctx context.Context, todoID string) (entries []*todo.TimeEntry, err error) {
+	err = r.read(ctx, func(s *state) error {
+		entries = s.listTimeEntries(todoID)
+		return nil
+	})
+	return entries, err
+}
+
+// TimeEntriesBetween returns the entries overlapping a date range.
+func (r *Repository) TimeEntriesBetween(ctx context.Context, from, to time.Time) (entries []*todo.TimeEntry, err error) {
+	err = r.read(ctx, func(s *state) error {
+		entries = s.timeEntriesBetween(from, to)
+		return nil
+	})
+	return entries, err
+}
+
+// PutCustomFieldSchema inserts or replaces the schema of a list.
+func (r *Repository) PutCustomFieldSchema(ctx context.Context, schema *todo.CustomFieldSchema) error {
+	return r.write(ctx, func(s *state) error {
+		s.putCustomFieldSchema(schema)
+		return nil
+	})
+}
+
+// CustomFieldSchemas returns the schemas of the given lists.
+func (r *Repository) CustomFieldSchemas(ctx context.Context, lists ...string) (schemas []*todo.CustomFieldSchema, err error) {
+	err = r.read(ctx, func(s *state) error {
+		schemas = s.customFieldSchemas(lists)
+		return nil
+	})
+	return schemas, err
+}
+
+// ListCustomFieldSchemas returns every schema sorted by list.
+func (r *Repository) ListCustomFieldSchemas(ctx context.Context) (schemas []*todo.CustomFieldSchema, err error) {
+	err = r.read(ctx, func(s *state) error {
+		schemas = s.listCustomFieldSchemas()
+		return nil
+	})
+	return schemas, err
+}
+
+// CreateTemplate inserts a template.
+func (r *Repository) CreateTemplate(ctx context.Context, template *todo.TodoTemplate) error {
+	return r.write(ctx, func(s *state) error { return s.createTemplate(template) })
+}
+
+// GetTemplate returns the template with the given ID.
+func (r *Repository) GetTemplate(ctx context.Context, id string) (template *todo.TodoTemplate, err error) {
+	err = r.read(ctx, func(s *state) error {
+		template, err = s.getTemplate(id)
+		return err
+	})
+	return template, err
+}
+
+// ListTemplates returns every template sorted by name.
+func (r *Repository) ListTemplates(ctx context.Context) (templates []*todo.TodoTemplate, err error) {
+	err = r.read(ctx, func(s *state) error {
+		templates = s.listTemplates()
+		return nil
+	})
+	return templates, err
+}
+
+// DeleteTemplate deletes a template.
+func (r *Repository) DeleteTemplate(ctx context.Context, id string) error {
+	return r.write(ctx, func(s *state) error { return s.deleteTemplate(id) })
+}
+
+// AppendEvents adds events to the outbox.
+func (r *Repository) AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error {
+	return r.write(ctx, func(s *state) error {
+		s.appendEvents(events)
+		return nil
+	})
+}
+
+// ClaimEvents returns the oldest event of each item, the whole repository
+// is locked during a transaction.
+func (r *Repository) ClaimEvents(ctx context.Context, limit int) (events []*todo.TodoEvent, err error) {
+	err = r.read(ctx, func(s *state) error {
+		events = s.claimEvents(limit)
+		return nil
+	})
+	return events, err
+}
+
+// DeleteEvents removes events from the outbox.
+func (r *Repository) DeleteEvents(ctx context.Context, ids ...int64) error {
+	return r.write(ctx, func(s *state) error {
+		s.deleteEvents(ids)
+		return nil
+	})
+}
+
+// tx is the repository passed to the functions run by Transaction,
+// which already hold the lock of the repository.
+type tx struct {
+	s *state
+}
+
+func (t *tx) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	return t.s.transaction(fn)
+}
+
+func (t *tx) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return t.s.createTodos(items)
+}
+
+func (t *tx) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	return t.s.getTodo(id)
+}
+
+func (t *tx) LockTodos(ctx context.Context, ids ...string) ([]*todo.Todo, error) {
+	return t.s.lockTodos(ids), nil
+}
+
+func (t *tx) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	return t.s.listTodos(f), nil
+}
+
+func (t *tx) CountTodos(ctx context.Context, f storage.Filter) (int, error) {
+	return len(t.s.match(f)), nil
+}
+
+func (t *tx) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	t.s.updateTodos(items)
+	return nil
+}
+
+func (t *tx) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	t.s.addTrackedSeconds(id, seconds)
+	return nil
+}
+
+func (t *tx) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	return t.s.deleteTodos(f), nil
+}
+
+func (t *tx) CreateTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	return t.s.createTimeEntry(entry)
+}
+
+func (t *tx) RunningTimeEntry(ctx context.Context, userID string) (*todo.TimeEntry, error) {
+	return t.s.runningTimeEntry(userID)
+}
+
+func (t *tx) StopTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	t.s.stopTimeEntry(entry)
+	return nil
+}
+
+func (t *tx) ListTimeEntries(ctx context.Context, todoID string) ([]*todo.TimeEntry, error) {
+	return t.s.listTimeEntries(todoID), nil
+}
+
+func (t *tx) TimeEntriesBetween(ctx context.Context, from, to time.Time) ([]*todo.TimeEntry, error) {
+	return t.s.timeEntriesBetween(from, to), nil
+}
+
+func (t *tx) PutCustomFieldSchema(ctx context.Context, schema *todo.CustomFieldSchema) error {
+	t.s.putCustomFieldSchema(schema)
+	return nil
+}
+
+func (t *tx) CustomFieldSchemas(ctx context.Context, lists ...string) ([]*todo.CustomFieldSchema, error) {
+	return t.s.customFieldSchemas(lists), nil
+}
+
+func (t *tx) ListCustomFieldSchemas(ctx context.Context) ([]*todo.CustomFieldSchema, error) {
+	return t.s.listCustomFieldSchemas(), nil
+}
+
+func (t *tx) CreateTemplate(ctx context.Context, template *todo.TodoTemplate) error {
+	return t.s.createTemplate(template)
+}
+
+func (t *tx) GetTemplate(ctx context.Context, id string) (*todo.TodoTemplate, error) {
+	return t.s.getTemplate(id)
+}
+
+func (t *tx) ListTemplates(ctx context.Context) ([]*todo.TodoTemplate, error) {
+	return t.s.listTemplates(), nil
+}
+
+func (t *tx) DeleteTemplate(ctx context.Context, id string) error {
+	return t.s.deleteTemplate(id)
+}
+
+func (t *tx) AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error {
+	t.s.appendEvents(events)
+	return nil
+}
+
+func (t *tx) ClaimEvents(ctx context.Context, limit int) ([]*todo.TodoEvent, error) {
+	return t.s.claimEvents(limit), nil
+}
+
+func (t *tx) DeleteEvents(ctx context.Context, ids ...int64) error {
+	t.s.deleteEvents(ids)
+	return nil
+}
+
+func copyTodo(item *todo.Todo) *todo.Todo {
+	return proto.Clone(item).(*todo.Todo)
+}
+
+func copyEntry(entry *todo.TimeEntry) *todo.TimeEntry {
+	return proto.Clone(entry).(*todo.TimeEntry)
+}
+
+func (s *state) createTodos(items []*todo.Todo) error {
+	for i, item := range items {
+		if _, ok := s.todos[item.Id]; ok {
+			return storage.ErrAlreadyExists
+		}
+		for _, other := range items[:i] {
+			if other.Id == item.Id {
+				return storage.ErrAlreadyExists
+			}
+		}
+	}
+	for _, item := range items {
+		if item.CreatedAt == nil {
+			item.CreatedAt = types.TimestampNow()
+		}
+		s.todos[item.Id] = copyTodo(item)
+		s.seq[item.Id] = s.next
+		s.next++
+	}
+	return nil
+}
+
+func (s *state) getTodo(id string) (*todo.Todo, error) {
+	item, ok := s.todos[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return copyTodo(item), nil
+}
+
+func (s *state) lockTodos(ids []string) []*todo.Todo {
+	var items []*todo.Todo
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if item, ok := s.todos[id]; ok && !seen[id] {
+			seen[id] = true
+			items = append(items, copyTodo(item))
+		}
+	}
+	return items
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns the stored items matching a filter, unsorted.
+func (s *state) match(f storage.Filter) []*todo.Todo {
+	var items []*todo.Todo
+next:
+	for _, item := range s.todos {
+		switch {
+		case f.IDs != nil && !contains(f.IDs, item.Id),
+			f.ParentIDs != nil && !contains(f.ParentIDs, item.ParentId),
+			f.NotCompleted && item.Completed,
+			f.OnlyCompleted && !item.Completed,
+			f.Status != "" && item.Status != f.Status,
+			f.List != "" && item.List != f.List,
+			f.AfterID != "" && item.Id <= f.AfterID:
+			continue
+		}
+		for _, kv := range f.CustomFields {
+			if v, ok := item.CustomFields[kv[0]]; !ok || v != kv[1] {
+				continue next
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func (s *state) listTodos(f storage.Filter) []*todo.Todo {
+	items := s.match(f)
+	less := compare(f.Order)
+	sort.Slice(items, func(i, j int) bool {
+		if c := less(items[i], items[j]); c != 0 {
+			return c < 0
+		}
+		return s.seq[items[i].Id] < s.seq[items[j].Id]
+	})
+	if f.Limit > 0 && len(items) > f.Limit {
+		items = items[:f.Limit]
+	}
+	var res []*todo.Todo
+	for _, item := range items {
+		res = append(res, copyTodo(item))
+	}
+	return res
+}
+
+// compare returns a function comparing items by the field of an order.
+// Missing values compare as greater than any value, as NULL does in
+// PostgreSQL, so that they come last in ascending order.
+func compare(o storage.Order) func(a, b *todo.Todo) int {
+	value := func(item *todo.Todo) (interface{}, bool) {
+		switch o.Field {
+		case "", "created_at":
+			return item.CreatedAt, item.CreatedAt != nil
+		case "updated_at":
+			return item.UpdatedAt, item.UpdatedAt != nil
+		case "id":
+			return item.Id, true
+		case "title":
+			return item.Title, item.Title != ""
+		case "status":
+			return item.Status, item.Status != ""
+		default:
+			v, ok := item.CustomFields[strings.TrimPrefix(o.Field, "custom_fields.")]
+			return v, ok
+		}
+	}
+	return func(a, b *todo.Todo) int {
+		va, oka := value(a)
+		vb, okb := value(b)
+		var c int
+		switch {
+		case !oka && !okb:
+			return 0
+		case !oka:
+			c = 1
+		case !okb:
+			c = -1
+		default:
+			c = compareValues(va, vb)
+		}
+		if o.Desc {
+			c = -c
+		}
+		return c
+	}
+}
+
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case *types.Timestamp:
+		b := b.(*types.Timestamp)
+		switch {
+		case a.Seconds != b.Seconds:
+			return sign(a.Seconds - b.Seconds)
+		default:
+			return sign(int64(a.Nanos) - int64(b.Nanos))
+		}
+	case string:
+		return strings.Compare(a, b.(string))
+	}
+	return 0
+}
+
+func sign(v int64) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
+
+func (s *state) updateTodos(items []*todo.Todo) {
+	for _, item := range items {
+		cur, ok := s.todos[item.Id]
+		if !ok {
+			continue
+		}
+		updated := copyTodo(cur)
+		u := copyTodo(item)
+		updated.Title = u.Title
+		updated.Description = u.Description
+		updated.Completed = u.Completed
+		updated.Status = u.Status
+		updated.Tags = u.Tags
+		updated.List = u.List
+		updated.CustomFields = u.CustomFields
+		updated.DueAt = u.DueAt
+		updated.Recurrence = u.Recurrence
+		updated.UpdatedAt = u.UpdatedAt
+		s.todos[item.Id] = updated
+	}
+}
+
+func (s *state) addTrackedSeconds(id string, seconds int64) {
+	cur, ok := s.todos[id]
+	if !ok {
+		return
+	}
+	updated := copyTodo(cur)
+	updated.TrackedSeconds += seconds
+	s.todos[id] = updated
+}
+
+func (s *state) deleteTodos(f storage.Filter) int {
+	items := s.match(f)
+	for _, item := range items {
+		delete(s.todos, item.Id)
+		delete(s.seq, item.Id)
+		for id, entry := range s.entries {
+			if entry.TodoId == item.Id {
+				delete(s.entries, id)
+			}
+		}
+	}
+	return len(items)
+}
+
+func (s *state) createTimeEntry(entry *todo.TimeEntry) error {
+	if _, ok := s.entries[entry.Id]; ok {
+		return storage.ErrAlreadyExists
+	}
+	if entry.StoppedAt == nil {
+		if _, err := s.runningTimeEntry(entry.UserId); err == nil {
+			return storage.ErrAlreadyExists
+		}
+	}
+	s.entries[entry.Id] = copyEntry(entry)
+	return nil
+}
+
+func (s *state) runningTimeEntry(userID string) (*todo.TimeEntry, error) {
+	for _, entry := range s.entries {
+		if entry.UserId == userID && entry.StoppedAt == nil {
+			return copyEntry(entry), nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+func (s *state) stopTimeEntry(entry *todo.TimeEntry) {
+	cur, ok := s.entries[entry.Id]
+	if !ok {
+		return
+	}
+	updated := copyEntry(cur)
+	updated.StoppedAt = proto.Clone(entry.StoppedAt).(*types.Timestamp)
+	updated.DurationSeconds = entry.DurationSeconds
+	s.entries[entry.Id] = updated
+}
+
+func (s *state) listTimeEntries(todoID string) []*todo.TimeEntry {
+	var entries []*todo.TimeEntry
+	for _, entry := range s.entries {
+		if entry.TodoId == todoID {
+			entries = append(entries, copyEntry(entry))
+		}
+	}
+	sortEntries(entries)
+	return entries
+}
+
+func (s *state) timeEntriesBetween(from, to time.Time) []*todo.TimeEntry {
+	var entries []*todo.TimeEntry
+	for _, entry := range s.entries {
+		if timestamp(entry.StartedAt).Before(to) && (entry.StoppedAt == nil || !timestamp(entry.StoppedAt).Before(from)) {
+			entries = append(entries, copyEntry(entry))
+		}
+	}
+	sortEntries(entries)
+	return entries
+}
+
+func sortEntries(entries []*todo.TimeEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if c := compareValues(entries[i].StartedAt, entries[j].StartedAt); c != 0 {
+			return c < 0
+		}
+		return entries[i].Id < entries[j].Id
+	})
+}
+
+func timestamp(ts *types.Timestamp) time.Time {
+	if ts == nil {
+		return time.Unix(0, 0)
+	}
+	return time.Unix(ts.Seconds, int64(ts.Nanos))
+}
+
+func (s *state) putCustomFieldSchema(schema *todo.CustomFieldSchema) {
+	s.schemas[schema.List] = proto.Clone(schema).(*todo.CustomFieldSchema)
+}
+
+func (s *state) customFieldSchemas(lists []string) []*todo.CustomFieldSchema {
+	var schemas []*todo.CustomFieldSchema
+	for _, list := range lists {
+		if schema, ok := s.schemas[list]; ok {
+			schemas = append(schemas, proto.Clone(schema).(*todo.CustomFieldSchema))
+		}
+	}
+	return schemas
+}
+
+func (s *state) listCustomFieldSchemas() []*todo.CustomFieldSchema {
+	lists := make([]string, 0, len(s.schemas))
+	for list := range s.schemas {
+		lists = append(lists, list)
+	}
+	sort.Strings(lists)
+	return s.customFieldSchemas(lists)
+}
+
+func (s *state) createTemplate(template *todo.TodoTemplate) error {
+	if _, ok := s.templates[template.Id]; ok {
+		return storage.ErrAlreadyExists
+	}
+	s.templates[template.Id] = proto.Clone(template).(*todo.TodoTemplate)
+	return nil
+}
+
+func (s *state) getTemplate(id string) (*todo.TodoTemplate, error) {
+	template, ok := s.templates[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return proto.Clone(template).(*todo.TodoTemplate), nil
+}
+
+func (s *state) listTemplates() []*todo.TodoTemplate {
+	var templates []*todo.TodoTemplate
+	for _, template := range s.templates {
+		templates = append(templates, proto.Clone(template).(*todo.TodoTemplate))
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Name != templates[j].Name {
+			return templates[i].Name < templates[j].Name
+		}
+		return templates[i].Id < templates[j].Id
+	})
+	return templates
+}
+
+func (s *state) deleteTemplate(id string) error {
+	if _, ok := s.templates[id]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(s.templates, id)
+	return nil
+}
+
+func (s *state) appendEvents(events []*todo.TodoEvent) {
+	for _, event := range events {
+		s.lastEvent++
+		event.Id = s.lastEvent
+		s.events[event.Id] = proto.Clone(event).(*todo.TodoEvent)
+	}
+}
+
+func (s *state) claimEvents(limit int) []*todo.TodoEvent {
+	ids := make([]int64, 0, len(s.events))
+	for id := range s.events {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	var events []*todo.TodoEvent
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if len(events) == limit {
+			break
+		}
+		event := s.events[id]
+		if seen[event.TodoId] {
+			continue
+		}
+		seen[event.TodoId] = true
+		events = append(events, proto.Clone(event).(*todo.TodoEvent))
+	}
+	return events
+}
+
+func (s *state) deleteEvents(ids []int64) {
+	for _, id := range ids {
+		delete(s.events, id)
+	}
+}
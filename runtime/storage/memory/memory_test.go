@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRecordsAreCopied(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+	item := &todo.Todo{Id: "1", Title: "stored", Tags: []string{"a"}}
+	assert.Nil(t, r.CreateTodos(ctx, item))
+	item.Tags[0] = "changed"
+
+	got, err := r.GetTodo(ctx, "1")
+	assert.Nil(t, err)
+	got.Title = "changed"
+	got, err = r.GetTodo(ctx, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "stored", got.Title)
+	assert.Equal(t, []string{"a"}, got.Tags)
+}
+
+func TestRunningTimeEntry(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+	assert.Nil(t, r.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "1", TodoId: "a", UserId: "alice", StartedAt: types.TimestampNow()}))
+	err := r.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "2", TodoId: "b", UserId: "alice", StartedAt: types.TimestampNow()})
+	assert.Equal(t, storage.ErrAlreadyExists, err)
+
+	entry, err := r.RunningTimeEntry(ctx, "alice")
+	assert.Nil(t, err)
+	entry.StoppedAt = types.TimestampNow()
+	assert.Nil(t, r.StopTimeEntry(ctx, entry))
+	_, err = r.RunningTimeEntry(ctx, "alice")
+	assert.Equal(t, storage.ErrNotFound, err)
+}
+
+func TestConformance(t *testing.T) {
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository { return New() },
+	})
+}
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/satori/go.uuid"
+)
+
+// cancelInterval is the interval between the cancel requests of the
+// statements of a done context: go-pg retries the canceled statements when
+// RetryStatementTimeout is set.
+const cancelInterval = 100 * time.Millisecond
+
+// statement runs fn with db bound to ctx, outside of an explicit
+// transaction. When ctx has a deadline, the read and write timeouts of the
+// connection are the time left. go-pg does not watch ctx: the statements of
+// fn are marked with a comment unique to the call, see mark, and once ctx is
+// done they are canceled with pg_cancel_backend until fn returns.
+func statement(ctx context.Context, db *pg.DB, fn func(conn *pg.DB, mark func(orm.DB) orm.DB) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	conn := db.WithContext(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		left := time.Until(deadline)
+		if left <= 0 {
+			return context.DeadlineExceeded
+		}
+		conn = conn.WithTimeout(left + timeoutMargin)
+	}
+	comment := "/* gotasks:" + uuid.NewV4().String() + " */ "
+	done := make(chan struct{})
+	go watch(ctx, db, comment, done)
+	err := fn(conn, func(db orm.DB) orm.DB {
+		return &marked{DB: db, ctx: ctx, comment: comment}
+	})
+	close(done)
+	// The statements canceled once ctx is done fail with query_canceled
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// watch cancels the statements starting with comment from the time ctx is
+// done until done is closed.
+func watch(ctx context.Context, db *pg.DB, comment string, done <-chan struct{}) {
+	select {
+	case <-done:
+		return
+	case <-ctx.Done():
+	}
+	ticker := time.NewTicker(cancelInterval)
+	defer ticker.Stop()
+	for {
+		// The cancel request of a statement is not bound to ctx, it is done
+		db.WithTimeout(timeoutMargin).Exec(`SELECT pg_cancel_backend(pid) FROM pg_stat_activity
+			WHERE pid <> pg_backend_pid() AND state = 'active' AND position(? IN query) = 1`, comment)
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// marked is an orm.DB whose statements start with a comment so that they
+// can be found in pg_stat_activity. Its statements are not sent once ctx is
+// done.
+type marked struct {
+	orm.DB
+	ctx     context.Context
+	comment string
+}
+
+// mark prefixes query with the comment of m.
+func (m *marked) mark(query interface{}) interface{} {
+	switch query := query.(type) {
+	case string:
+		return m.comment + query
+	case orm.QueryAppender:
+		return markedQuery{QueryAppender: query, comment: m.comment}
+	}
+	return query
+}
+
+func (m *marked) Model(model ...interface{}) *orm.Query {
+	return orm.NewQuery(m, model...)
+}
+
+func (m *marked) Select(model interface{}) error {
+	return orm.Select(m, model)
+}
+
+func (m *marked) Insert(model ...interface{}) error {
+	return orm.Insert(m, model...)
+}
+
+func (m *marked) Update(model interface{}) error {
+	return orm.Update(m, model)
+}
+
+func (m *marked) Delete(model interface{}) error {
+	return orm.Delete(m, model)
+}
+
+func (m *marked) ForceDelete(model interface{}) error {
+	return orm.ForceDelete(m, model)
+}
+
+func (m *marked) Exec(query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.Exec(m.mark(query), params...)
+}
+
+func (m *marked) ExecOne(query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.ExecOne(m.mark(query), params...)
+}
+
+func (m *marked) Query(model, query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.Query(model, m.mark(query), params...)
+}
+
+func (m *marked) QueryOne(model, query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.QueryOne(model, m.mark(query), params...)
+}
+
+func (m *marked) CopyFrom(r io.Reader, query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.CopyFrom(r, m.mark(query), params...)
+}
+
+func (m *marked) CopyTo(w io.Writer, query interface{}, params ...interface{}) (orm.Result, error) {
+	if err := m.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return m.DB.CopyTo(w, m.mark(query), params...)
+}
+
+// markedQuery is a query of the orm starting with a comment.
+type markedQuery struct {
+	orm.QueryAppender
+	comment string
+}
+
+func (q markedQuery) Copy() orm.QueryAppender {
+	return markedQuery{QueryAppender: q.QueryAppender.Copy(), comment: q.comment}
+}
+
+func (q markedQuery) AppendQuery(dst []byte) ([]byte, error) {
+	return q.QueryAppender.AppendQuery(append(dst, q.comment...))
+}
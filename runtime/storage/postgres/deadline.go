@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg"
+)
+
+// timeoutMargin is added to the time left before a deadline for the read
+// and write timeouts of the connection, so that postgres cancels the
+// statement first and the connection stays usable.
+const timeoutMargin = time.Second
+
+// begin runs fn in an explicit transaction of db bound to ctx. When ctx has
+// a deadline, the statement_timeout of the transaction is the time left, so
+// that postgres cancels its statements once the client gave up. The single
+// statements outside of a transaction are canceled by statement instead.
+func begin(ctx context.Context, db *pg.DB, fn func(*pg.Tx) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	db = db.WithContext(ctx)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return db.RunInTransaction(fn)
+	}
+	left := time.Until(deadline)
+	if left <= 0 {
+		return context.DeadlineExceeded
+	}
+	err := db.WithTimeout(left + timeoutMargin).RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.Exec("SET LOCAL statement_timeout = ?", statementTimeout(left)); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+	// The statements canceled at the deadline fail with query_canceled
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// statementTimeout returns d in milliseconds, at least one as zero disables
+// the timeout.
+func statementTimeout(d time.Duration) int64 {
+	if ms := int64(d / time.Millisecond); ms > 0 {
+		return ms
+	}
+	return 1
+}
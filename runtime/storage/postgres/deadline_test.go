@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadline(t *testing.T) {
+	// Nothing listens, the queries must fail before connecting
+	db := pg.Connect(&pg.Options{Addr: "127.0.0.1:1"})
+	defer db.Close()
+	repo := New(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := repo.GetTodo(ctx, "1")
+	assert.Equal(t, context.Canceled, err)
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	called := false
+	err = repo.Transaction(ctx, func(storage.Repository) error {
+		called = true
+		return nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.False(t, called)
+
+	assert.Equal(t, int64(1500), statementTimeout(1500*time.Millisecond))
+	assert.Equal(t, int64(1), statementTimeout(time.Microsecond))
+}
+
+// TestCancel checks against the database of TestTodoTestSuite that the
+// statements of a canceled context are canceled by postgres.
+func TestCancel(t *testing.T) {
+	db := connect()
+	defer db.Close()
+	repo := New(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	err := repo.run(ctx, func(db orm.DB) error {
+		_, err := db.Exec("SELECT pg_sleep(10)")
+		return err
+	})
+	assert.Equal(t, context.Canceled, err)
+	// go-pg retries the canceled statement after a backoff, each retry is
+	// canceled in turn
+	assert.True(t, time.Since(start) < 8*time.Second, "pg_sleep ran for %v", time.Since(start))
+
+	// The connection stays usable
+	assert.Nil(t, repo.Ping(context.Background()))
+}
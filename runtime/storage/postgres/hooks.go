@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/gofunct/gotasks/runtime/logging"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+var (
+	// literal matches the string and number literals of a statement.
+	literal = regexp.MustCompile(`'(?:[^']|'')*'|\b\d+(?:\.\d+)?\b`)
+	// marker matches the leading comment marking a statement to cancel, see
+	// statement.
+	marker = regexp.MustCompile(`^/\*.*?\*/\s*`)
+	// table matches the first table read or written by a statement.
+	table = regexp.MustCompile(`(?i)\b(?:FROM|INTO|UPDATE)\s+"?(\w+)"?`)
+	// operations are the first keywords of the statements reported as is,
+	// the others are reported as other.
+	operations = map[string]bool{
+		"select": true, "insert": true, "update": true, "delete": true,
+		"begin": true, "commit": true, "rollback": true, "set": true,
+	}
+)
+
+// QueryHook observes the queries run by go-pg. It records their duration
+// by operation and table, logs the queries slower than a threshold with
+// their literals redacted, and reports each query as a child span of the
+// span of its context.
+type QueryHook struct {
+	log       *logging.Logger
+	slow      time.Duration
+	durations *prometheus.HistogramVec
+}
+
+// NewQueryHook returns a hook logging the queries lasting slow or more
+// with log, none when slow is zero.
+func NewQueryHook(log *logging.Logger, slow time.Duration) *QueryHook {
+	return &QueryHook{
+		log:  log,
+		slow: slow,
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gotasks_db_query_duration_seconds",
+			Help:    "Duration of the database queries by operation and table.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation", "table"}),
+	}
+}
+
+// WithQueryHook returns a copy of the repository whose primary and replicas
+// report their queries to h, and whose metrics include those of h.
+func (r *Repository) WithQueryHook(h *QueryHook) *Repository {
+	r.db.OnQueryProcessed(h.process)
+	if r.replicas != nil {
+		for _, p := range r.replicas.pools {
+			p.db.OnQueryProcessed(h.process)
+		}
+	}
+	c := *r
+	c.hook = h
+	return &c
+}
+
+func (h *QueryHook) process(ev *pg.QueryProcessedEvent) {
+	elapsed := time.Since(ev.StartTime)
+	query, err := ev.UnformattedQuery()
+	if err != nil {
+		return
+	}
+	statement := redact(query)
+	op, tbl := describe(statement)
+	h.durations.WithLabelValues(op, tbl).Observe(elapsed.Seconds())
+	if h.slow > 0 && elapsed >= h.slow {
+		h.log.Zap.Warn("slow query",
+			zap.Duration("duration", elapsed),
+			zap.String("operation", op),
+			zap.String("table", tbl),
+			zap.String("query", statement),
+			zap.Error(ev.Error))
+	}
+	h.trace(ev, op, tbl, statement)
+}
+
+// trace reports a query as a span, when its context has one.
+func (h *QueryHook) trace(ev *pg.QueryProcessedEvent, op, tbl, statement string) {
+	if ev.DB == nil {
+		return
+	}
+	parent := opentracing.SpanFromContext(ev.DB.Context())
+	if parent == nil {
+		return
+	}
+	span := parent.Tracer().StartSpan("sql."+op, opentracing.ChildOf(parent.Context()), opentracing.StartTime(ev.StartTime))
+	ext.SpanKindRPCClient.Set(span)
+	ext.DBType.Set(span, "sql")
+	ext.DBStatement.Set(span, statement)
+	span.SetTag("db.table", tbl)
+	if ev.Error != nil && ev.Error != pg.ErrNoRows {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(ev.Error))
+	}
+	span.Finish()
+}
+
+// redact replaces the literals of a statement with placeholders and drops
+// its leading comment.
+func redact(statement string) string {
+	return literal.ReplaceAllString(marker.ReplaceAllString(statement, ""), "?")
+}
+
+// describe returns the operation of a statement and the first table it
+// reads or writes, if any.
+func describe(statement string) (op, tbl string) {
+	fields := strings.Fields(statement)
+	op = "other"
+	if len(fields) > 0 && operations[strings.ToLower(fields[0])] {
+		op = strings.ToLower(fields[0])
+	}
+	if m := table.FindStringSubmatch(statement); m != nil {
+		tbl = m[1]
+	}
+	return op, tbl
+}
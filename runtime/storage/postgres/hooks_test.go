@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/gofunct/gotasks/runtime/logging"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestQueryHook(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	h := NewQueryHook(&logging.Logger{Zap: zap.New(core)}, time.Second)
+	tracer := mocktracer.New()
+	parent := tracer.StartSpan("GetTodo")
+	db := pg.Connect(&pg.Options{Addr: "127.0.0.1:1"})
+	defer db.Close()
+
+	// The comment marking the statements to cancel is dropped
+	query := `/* gotasks:0b6c7e4e-1f4b-4d1a-9c53-2f0e8e1f6a42 */ SELECT "todo"."id" FROM "todos" AS "todo" WHERE (id = 'secret') AND (n = 42)`
+	h.process(&pg.QueryProcessedEvent{
+		StartTime: time.Now().Add(-2 * time.Second),
+		DB:        db.WithContext(opentracing.ContextWithSpan(context.Background(), parent)),
+		Query:     query,
+	})
+	h.process(&pg.QueryProcessedEvent{StartTime: time.Now(), DB: db, Query: "COMMIT"})
+
+	// Only the slow query is logged, redacted
+	assert.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "slow query", entry.Message)
+	assert.Equal(t, `SELECT "todo"."id" FROM "todos" AS "todo" WHERE (id = ?) AND (n = ?)`, entry.ContextMap()["query"])
+	assert.Equal(t, "todos", entry.ContextMap()["table"])
+
+	// Only the query run under a span is traced
+	spans := tracer.FinishedSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "sql.select", spans[0].OperationName)
+	assert.Equal(t, parent.Context().(mocktracer.MockSpanContext).SpanID, spans[0].ParentID)
+	assert.Equal(t, "todos", spans[0].Tag("db.table"))
+
+	assert.Equal(t, uint64(1), sampleCount(t, h.durations.WithLabelValues("select", "todos")))
+	assert.Equal(t, uint64(1), sampleCount(t, h.durations.WithLabelValues("commit", "")))
+}
+
+func sampleCount(t *testing.T, o prometheus.Observer) uint64 {
+	var m dto.Metric
+	assert.Nil(t, o.(prometheus.Metric).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestDescribe(t *testing.T) {
+	op, tbl := describe(`DELETE FROM "time_entries" AS "time_entry" WHERE (todo_id IN (SELECT "id" FROM "todos"))`)
+	assert.Equal(t, "delete", op)
+	assert.Equal(t, "time_entries", tbl)
+	op, tbl = describe(`INSERT INTO "todo_events" ("id") VALUES (DEFAULT)`)
+	assert.Equal(t, "insert", op)
+	assert.Equal(t, "todo_events", tbl)
+	op, tbl = describe("WITH x AS (SELECT 1) SELECT * FROM x")
+	assert.Equal(t, "other", op)
+	assert.Equal(t, "x", tbl)
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	poolConnections = prometheus.NewDesc("gotasks_db_pool_connections",
+		"Number of connections of each database pool by state, total or idle.", []string{"pool", "state"}, nil)
+	poolHits = prometheus.NewDesc("gotasks_db_pool_hits_total",
+		"Number of times an idle connection was found in each database pool.", []string{"pool"}, nil)
+	poolMisses = prometheus.NewDesc("gotasks_db_pool_misses_total",
+		"Number of times no idle connection was found in each database pool.", []string{"pool"}, nil)
+	poolTimeouts = prometheus.NewDesc("gotasks_db_pool_timeouts_total",
+		"Number of times waiting for a connection of each database pool timed out.", []string{"pool"}, nil)
+	replicaLag = prometheus.NewDesc("gotasks_db_replica_lag_seconds",
+		"Replication lag of each replica at its last check.", []string{"pool"}, nil)
+	replicaHealthy = prometheus.NewDesc("gotasks_db_replica_healthy",
+		"Whether each replica serves reads, 1, or is ejected, 0.", []string{"pool"}, nil)
+)
+
+// Describe implements the prometheus Collector interface.
+func (r *Repository) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{poolConnections, poolHits, poolMisses, poolTimeouts, replicaLag, replicaHealthy} {
+		ch <- desc
+	}
+	if r.hook != nil {
+		r.hook.durations.Describe(ch)
+	}
+	if r.replicas != nil {
+		r.replicas.reads.Describe(ch)
+	}
+}
+
+// Collect implements the prometheus Collector interface, reporting the
+// statistics of the connection pools of the primary and of the replicas,
+// and the durations of the queries.
+func (r *Repository) Collect(ch chan<- prometheus.Metric) {
+	collectPool(ch, "primary", r.db)
+	if r.hook != nil {
+		r.hook.durations.Collect(ch)
+	}
+	if r.replicas == nil {
+		return
+	}
+	for _, p := range r.replicas.pools {
+		collectPool(ch, p.name, p.db)
+		lag := time.Duration(atomic.LoadInt64(&p.lag))
+		ch <- prometheus.MustNewConstMetric(replicaLag, prometheus.GaugeValue, lag.Seconds(), p.name)
+		ch <- prometheus.MustNewConstMetric(replicaHealthy, prometheus.GaugeValue, float64(atomic.LoadInt32(&p.healthy)), p.name)
+	}
+	r.replicas.reads.Collect(ch)
+}
+
+func collectPool(ch chan<- prometheus.Metric, pool string, db *pg.DB) {
+	stats := db.PoolStats()
+	ch <- prometheus.MustNewConstMetric(poolConnections, prometheus.GaugeValue, float64(stats.TotalConns), pool, "total")
+	ch <- prometheus.MustNewConstMetric(poolConnections, prometheus.GaugeValue, float64(stats.IdleConns), pool, "idle")
+	ch <- prometheus.MustNewConstMetric(poolHits, prometheus.CounterValue, float64(stats.Hits), pool)
+	ch <- prometheus.MustNewConstMetric(poolMisses, prometheus.CounterValue, float64(stats.Misses), pool)
+	ch <- prometheus.MustNewConstMetric(poolTimeouts, prometheus.CounterValue, float64(stats.Timeouts), pool)
+}
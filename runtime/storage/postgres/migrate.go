@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/gofunct/gotasks/runtime/migrate"
+)
+
+// lockKey identifies the advisory lock taken while migrating, "gotasks" in ASCII.
+const lockKey = 0x676f7461736b73
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrations are the migrations of the PostgreSQL schema.
+var Migrations = migrate.MustLoad(migrationFiles, "migrations")
+
+// Migrator returns the migrator of the schema of the database of r.
+func (r *Repository) Migrator() migrate.Migrator {
+	return migrate.Migrator{DB: database{r.db}, Migrations: Migrations}
+}
+
+type database struct {
+	db *pg.DB
+}
+
+// Locked runs fn in a transaction holding an advisory lock, released when
+// the transaction ends. DDL statements are transactional in PostgreSQL,
+// a failing migration leaves the schema unchanged.
+func (d database) Locked(ctx context.Context, fn func(migrate.Tx) error) error {
+	return d.db.WithContext(ctx).RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.Exec("SELECT pg_advisory_xact_lock(?)", lockKey); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)`)
+		if err != nil {
+			return err
+		}
+		return fn(migrationTx{tx})
+	})
+}
+
+// Versions reads schema_migrations outside of the lock.
+func (d database) Versions(ctx context.Context) ([]int, error) {
+	db := d.db.WithContext(ctx)
+	var exists bool
+	if _, err := db.QueryOne(pg.Scan(&exists), "SELECT to_regclass('schema_migrations') IS NOT NULL"); err != nil || !exists {
+		return nil, err
+	}
+	return versions(db)
+}
+
+// querier is implemented by *pg.DB and *pg.Tx.
+type querier interface {
+	Query(model, query interface{}, params ...interface{}) (orm.Result, error)
+}
+
+func versions(q querier) ([]int, error) {
+	var versions []int
+	_, err := q.Query(pg.Scan(&versions), "SELECT version FROM schema_migrations ORDER BY version")
+	return versions, err
+}
+
+type migrationTx struct {
+	tx *pg.Tx
+}
+
+func (t migrationTx) Versions(ctx context.Context) ([]int, error) {
+	return versions(t.tx)
+}
+
+func (t migrationTx) Exec(ctx context.Context, script string) error {
+	_, err := t.tx.Exec(script)
+	return err
+}
+
+func (t migrationTx) Insert(ctx context.Context, m migrate.Migration) error {
+	_, err := t.tx.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", m.Version, m.Name)
+	return err
+}
+
+func (t migrationTx) Delete(ctx context.Context, version int) error {
+	_, err := t.tx.Exec("DELETE FROM schema_migrations WHERE version = ?", version)
+	return err
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS todo_templates;
+DROP TABLE IF EXISTS custom_field_schemas;
+DROP TABLE IF EXISTS time_entries;
+DROP TABLE IF EXISTS todos;
@@ -0,0 +1,80 @@
+-- Tables as created by go-pg from the generated structs before migrations
+-- existed, so that existing databases are adopted as they are. The columns
+-- added to the structs since are added to the tables created without them
+-- and the xxx_ columns of their former XXX_ fields are dropped.
+CREATE TABLE IF NOT EXISTS todos (
+	id text,
+	title text,
+	description text,
+	completed boolean,
+	created_at jsonb,
+	updated_at jsonb,
+	PRIMARY KEY (id)
+);
+
+ALTER TABLE todos
+	ADD COLUMN IF NOT EXISTS status text,
+	ADD COLUMN IF NOT EXISTS tags jsonb,
+	ADD COLUMN IF NOT EXISTS list text,
+	ADD COLUMN IF NOT EXISTS tracked_seconds bigint,
+	ADD COLUMN IF NOT EXISTS custom_fields jsonb,
+	ADD COLUMN IF NOT EXISTS parent_id text,
+	ADD COLUMN IF NOT EXISTS due_at jsonb,
+	ADD COLUMN IF NOT EXISTS recurrence text;
+
+ALTER TABLE todos
+	DROP COLUMN IF EXISTS xxx__no_unkeyed_literal,
+	DROP COLUMN IF EXISTS xxx_unrecognized,
+	DROP COLUMN IF EXISTS xxx_sizecache;
+
+CREATE TABLE IF NOT EXISTS time_entries (
+	id text,
+	PRIMARY KEY (id)
+);
+
+ALTER TABLE time_entries
+	ADD COLUMN IF NOT EXISTS todo_id text,
+	ADD COLUMN IF NOT EXISTS user_id text,
+	ADD COLUMN IF NOT EXISTS started_at jsonb,
+	ADD COLUMN IF NOT EXISTS stopped_at jsonb,
+	ADD COLUMN IF NOT EXISTS duration_seconds bigint;
+
+ALTER TABLE time_entries
+	DROP COLUMN IF EXISTS xxx__no_unkeyed_literal,
+	DROP COLUMN IF EXISTS xxx_unrecognized,
+	DROP COLUMN IF EXISTS xxx_sizecache;
+
+-- A user has at most one running timer
+CREATE UNIQUE INDEX IF NOT EXISTS time_entries_running_user_id ON time_entries (user_id) WHERE stopped_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS custom_field_schemas (
+	list text
+);
+
+ALTER TABLE custom_field_schemas
+	ADD COLUMN IF NOT EXISTS schema text,
+	ADD COLUMN IF NOT EXISTS updated_at jsonb;
+
+ALTER TABLE custom_field_schemas
+	DROP COLUMN IF EXISTS xxx__no_unkeyed_literal,
+	DROP COLUMN IF EXISTS xxx_unrecognized,
+	DROP COLUMN IF EXISTS xxx_sizecache;
+
+-- A list has at most one schema
+CREATE UNIQUE INDEX IF NOT EXISTS custom_field_schemas_list ON custom_field_schemas (list);
+
+CREATE TABLE IF NOT EXISTS todo_templates (
+	id text,
+	PRIMARY KEY (id)
+);
+
+ALTER TABLE todo_templates
+	ADD COLUMN IF NOT EXISTS name text,
+	ADD COLUMN IF NOT EXISTS list text,
+	ADD COLUMN IF NOT EXISTS item jsonb,
+	ADD COLUMN IF NOT EXISTS created_at jsonb;
+
+ALTER TABLE todo_templates
+	DROP COLUMN IF EXISTS xxx__no_unkeyed_literal,
+	DROP COLUMN IF EXISTS xxx_unrecognized,
+	DROP COLUMN IF EXISTS xxx_sizecache;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS time_entries_todo_id;
+DROP INDEX IF EXISTS todos_list;
+DROP INDEX IF EXISTS todos_parent_id;
@@ -0,0 +1,4 @@
+-- Subtasks are looked up by parent, time entries by item
+CREATE INDEX IF NOT EXISTS todos_parent_id ON todos (parent_id);
+CREATE INDEX IF NOT EXISTS todos_list ON todos (list);
+CREATE INDEX IF NOT EXISTS time_entries_todo_id ON time_entries (todo_id);
@@ -0,0 +1,11 @@
+-- Outbox of the changes of the todos, published by the relay
+CREATE TABLE todo_events (
+	id bigserial,
+	todo_id text,
+	type integer,
+	item jsonb,
+	created_at jsonb,
+	PRIMARY KEY (id)
+);
+
+CREATE INDEX todo_events_todo_id ON todo_events (todo_id, id);
@@ -0,0 +1,7 @@
+DROP POLICY todos_tenant_isolation ON todos;
+
+ALTER TABLE todos DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX todos_tenant_id;
+
+ALTER TABLE todos DROP COLUMN tenant_id;
@@ -0,0 +1,12 @@
+-- Row level security isolating the todos of each tenant. The policy only
+-- applies to the tenant role taken by the service when db_tenant_role is
+-- set, the owner of the table bypasses it.
+ALTER TABLE todos ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('gotasks.tenant', true), '');
+
+CREATE INDEX todos_tenant_id ON todos (tenant_id);
+
+ALTER TABLE todos ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY todos_tenant_isolation ON todos
+	USING (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''))
+	WITH CHECK (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''));
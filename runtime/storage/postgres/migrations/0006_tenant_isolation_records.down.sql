@@ -0,0 +1,23 @@
+DROP POLICY todo_events_tenant_isolation ON todo_events;
+DROP POLICY custom_field_schemas_tenant_isolation ON custom_field_schemas;
+DROP POLICY todo_templates_tenant_isolation ON todo_templates;
+DROP POLICY time_entries_tenant_isolation ON time_entries;
+
+ALTER TABLE todo_events DISABLE ROW LEVEL SECURITY;
+ALTER TABLE custom_field_schemas DISABLE ROW LEVEL SECURITY;
+ALTER TABLE todo_templates DISABLE ROW LEVEL SECURITY;
+ALTER TABLE time_entries DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX custom_field_schemas_list;
+CREATE UNIQUE INDEX custom_field_schemas_list ON custom_field_schemas (list);
+DROP INDEX time_entries_running_user_id;
+CREATE UNIQUE INDEX time_entries_running_user_id ON time_entries (user_id) WHERE stopped_at IS NULL;
+
+DROP INDEX todo_events_tenant_id;
+DROP INDEX todo_templates_tenant_id;
+DROP INDEX time_entries_tenant_id;
+
+ALTER TABLE todo_events DROP COLUMN tenant_id;
+ALTER TABLE custom_field_schemas DROP COLUMN tenant_id;
+ALTER TABLE todo_templates DROP COLUMN tenant_id;
+ALTER TABLE time_entries DROP COLUMN tenant_id;
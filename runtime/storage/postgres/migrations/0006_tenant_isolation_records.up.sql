@@ -0,0 +1,38 @@
+-- Row level security isolating the time entries, templates, custom field
+-- schemas and events of each tenant, as migration 4 does for the todos.
+ALTER TABLE time_entries ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('gotasks.tenant', true), '');
+ALTER TABLE todo_templates ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('gotasks.tenant', true), '');
+ALTER TABLE custom_field_schemas ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('gotasks.tenant', true), '');
+ALTER TABLE todo_events ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('gotasks.tenant', true), '');
+
+CREATE INDEX time_entries_tenant_id ON time_entries (tenant_id);
+CREATE INDEX todo_templates_tenant_id ON todo_templates (tenant_id);
+CREATE INDEX todo_events_tenant_id ON todo_events (tenant_id);
+
+-- A user has at most one running timer and a list at most one schema in
+-- each tenant, NULL being the tenant of the records written without one
+DROP INDEX time_entries_running_user_id;
+CREATE UNIQUE INDEX time_entries_running_user_id ON time_entries ((COALESCE(tenant_id, '')), user_id) WHERE stopped_at IS NULL;
+DROP INDEX custom_field_schemas_list;
+CREATE UNIQUE INDEX custom_field_schemas_list ON custom_field_schemas ((COALESCE(tenant_id, '')), list);
+
+ALTER TABLE time_entries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE todo_templates ENABLE ROW LEVEL SECURITY;
+ALTER TABLE custom_field_schemas ENABLE ROW LEVEL SECURITY;
+ALTER TABLE todo_events ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY time_entries_tenant_isolation ON time_entries
+	USING (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''))
+	WITH CHECK (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''));
+
+CREATE POLICY todo_templates_tenant_isolation ON todo_templates
+	USING (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''))
+	WITH CHECK (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''));
+
+CREATE POLICY custom_field_schemas_tenant_isolation ON custom_field_schemas
+	USING (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''))
+	WITH CHECK (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''));
+
+CREATE POLICY todo_events_tenant_isolation ON todo_events
+	USING (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''))
+	WITH CHECK (tenant_id = NULLIF(current_setting('gotasks.tenant', true), ''));
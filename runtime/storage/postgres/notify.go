@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-pg/pg/orm"
+)
+
+// Notifier sends and receives messages on a channel of the primary
+// database with NOTIFY and LISTEN.
+type Notifier struct {
+	r       *Repository
+	channel string
+}
+
+// Notifier returns a notifier of the channel.
+func (r *Repository) Notifier(channel string) *Notifier {
+	return &Notifier{r: r, channel: channel}
+}
+
+// Notify sends payload to the listeners of the channel.
+func (n *Notifier) Notify(ctx context.Context, payload string) error {
+	return n.r.run(ctx, func(db orm.DB) error {
+		_, err := db.Exec("SELECT pg_notify(?, ?)", n.channel, payload)
+		return err
+	})
+}
+
+// Listen calls fn with the payload of each message of the channel until ctx
+// is done. The listener reconnects when the connection is lost, messages
+// sent in the meantime are lost.
+func (n *Notifier) Listen(ctx context.Context, fn func(payload string)) error {
+	ln := n.r.db.Listen(n.channel)
+	defer ln.Close()
+	ch := ln.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return errors.New("postgres: listener closed")
+			}
+			fn(msg.Payload)
+		}
+	}
+}
@@ -0,0 +1,517 @@
+// Package postgres stores the records of the todo service in PostgreSQL
+// through the go-pg ORM.
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/types"
+)
+
+// tenantSetting is the setting holding the tenant of a transaction, read
+// by the row level security policies of the todos.
+const tenantSetting = "gotasks.tenant"
+
+// updatedColumns are the columns written by UpdateTodos.
+var updatedColumns = []string{"title", "description", "completed", "status", "tags", "list", "custom_fields", "due_at", "recurrence", "updated_at"}
+
+// Repository is a storage.Repository backed by PostgreSQL.
+type Repository struct {
+	db *pg.DB
+	// replicas serve the reads allowed to be stale when set.
+	replicas *Replicas
+	// hook observes the queries when set.
+	hook *QueryHook
+	// tenantRole is the role isolating the tenants when set.
+	tenantRole string
+	// tx is set for the repositories passed to Transaction.
+	tx *pg.Tx
+}
+
+// New returns a repository storing its records in db.
+func New(db *pg.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// WithReplicas returns a copy of the repository serving the reads allowed
+// to be stale, see storage.AllowStale, from replicas.
+func (r *Repository) WithReplicas(replicas *Replicas) *Repository {
+	c := *r
+	c.replicas = replicas
+	return &c
+}
+
+// WithTenantRole returns a copy of the repository isolating the tenants:
+// each transaction takes role, subject to the row level security policies
+// of the tables, and the tenant of its context, see storage.WithTenant.
+// Every query runs in a transaction, and queries without a tenant see no
+// record. The queries of a context spanning all the tenants, such as those
+// of the outbox relay, keep the role of the connection, see
+// storage.WithAllTenants.
+func (r *Repository) WithTenantRole(role string) *Repository {
+	c := *r
+	c.tenantRole = role
+	return &c
+}
+
+// Watch checks the replicas of the repository, if any, every interval
+// until ctx is done.
+func (r *Repository) Watch(ctx context.Context, interval time.Duration) {
+	if r.replicas != nil {
+		r.replicas.Watch(ctx, interval)
+	}
+}
+
+// Ping checks that the primary database answers.
+func (r *Repository) Ping(ctx context.Context) error {
+	return r.run(ctx, func(db orm.DB) error {
+		_, err := db.Exec("SELECT 1")
+		return err
+	})
+}
+
+// run runs fn with the transaction of the repository or the primary
+// database, see on.
+func (r *Repository) run(ctx context.Context, fn func(orm.DB) error) error {
+	return r.on(ctx, r.db, fn)
+}
+
+// read runs fn like run, on a healthy replica when the reads of ctx are
+// allowed to be stale.
+func (r *Repository) read(ctx context.Context, fn func(orm.DB) error) error {
+	return r.on(ctx, r.pool(ctx), fn)
+}
+
+// pool returns a healthy replica when the reads of ctx are allowed to be
+// stale and the repository is not in a transaction, and the primary
+// database otherwise.
+func (r *Repository) pool(ctx context.Context) *pg.DB {
+	if r.tx == nil && r.replicas != nil && storage.StaleAllowed(ctx) {
+		if db := r.replicas.pick(); db != nil {
+			return db
+		}
+	}
+	return r.db
+}
+
+// on runs fn with the transaction of the repository or with db bound to ctx,
+// see statement. When the tenants are isolated fn runs in a transaction
+// scoped to the tenant of ctx.
+func (r *Repository) on(ctx context.Context, db *pg.DB, fn func(orm.DB) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+	return statement(ctx, db, func(conn *pg.DB, mark func(orm.DB) orm.DB) error {
+		if r.tenantRole == "" {
+			return fn(mark(conn))
+		}
+		return conn.RunInTransaction(func(tx *pg.Tx) error {
+			if err := r.scope(ctx, tx); err != nil {
+				return err
+			}
+			return fn(mark(tx))
+		})
+	})
+}
+
+// transaction runs fn in a new transaction of db, see begin, scoped to the
+// tenant of ctx when the tenants are isolated.
+func (r *Repository) transaction(ctx context.Context, db *pg.DB, fn func(*pg.Tx) error) error {
+	return begin(ctx, db, func(tx *pg.Tx) error {
+		if err := r.scope(ctx, tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// scope sets the role and the tenant of tx when the tenants are isolated,
+// unless ctx spans all the tenants.
+func (r *Repository) scope(ctx context.Context, tx *pg.Tx) error {
+	if r.tenantRole == "" || storage.AllTenants(ctx) {
+		return nil
+	}
+	_, err := tx.Exec("SELECT set_config('role', ?, true), set_config(?, ?, true)",
+		r.tenantRole, tenantSetting, storage.Tenant(ctx))
+	return err
+}
+
+// atomic runs fn in the transaction of the repository or in a new one.
+func (r *Repository) atomic(ctx context.Context, fn func(orm.DB) error) error {
+	if r.tx != nil {
+		return r.run(ctx, fn)
+	}
+	return r.transaction(ctx, r.db, func(tx *pg.Tx) error {
+		return fn(tx)
+	})
+}
+
+// Transaction runs fn in a database transaction.
+func (r *Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+	return r.transaction(ctx, r.db, func(tx *pg.Tx) error {
+		return fn(&Repository{db: r.db, tx: tx})
+	})
+}
+
+// translate maps the errors of go-pg to the errors of the storage package.
+func translate(err error) error {
+	if err == pg.ErrNoRows {
+		return storage.ErrNotFound
+	}
+	if pgErr, ok := err.(pg.Error); ok && pgErr.Field('C') == "23505" {
+		return storage.ErrAlreadyExists
+	}
+	return err
+}
+
+// filter restricts a query to the items matching f.
+func filter(f storage.Filter) func(*orm.Query) (*orm.Query, error) {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if f.IDs != nil {
+			in(query, "id", f.IDs)
+		}
+		if f.ParentIDs != nil {
+			in(query, "parent_id", f.ParentIDs)
+		}
+		// Items inserted as not completed store NULL
+		if f.NotCompleted {
+			query.Where("completed IS NOT TRUE")
+		}
+		if f.OnlyCompleted {
+			query.Where("completed = true")
+		}
+		if f.Status != "" {
+			query.Where("status = ?", f.Status)
+		}
+		if f.List != "" {
+			query.Where("list = ?", f.List)
+		}
+		for _, kv := range f.CustomFields {
+			query.Where("custom_fields->>? = ?", kv[0], kv[1])
+		}
+		if f.AfterID != "" {
+			query.Where("id > ?", f.AfterID)
+		}
+		return query, nil
+	}
+}
+
+// in restricts a query to the rows whose column is one of values.
+func in(query *orm.Query, column string, values []string) {
+	if len(values) == 0 {
+		query.Where("FALSE")
+		return
+	}
+	query.Where("? IN (?)", pg.F(column), pg.In(values))
+}
+
+// order sorts a query. Timestamps are stored as JSON documents,
+// they are sorted by their seconds then nanoseconds.
+func order(query *orm.Query, o storage.Order) {
+	dir := " ASC"
+	if o.Desc {
+		dir = " DESC"
+	}
+	field := o.Field
+	if field == "" {
+		field = "created_at"
+	}
+	switch {
+	case field == "created_at", field == "updated_at":
+		query.OrderExpr("(?->>'seconds')::bigint"+dir+", (?->>'nanos')::int"+dir, pg.F(field), pg.F(field))
+	case strings.HasPrefix(field, "custom_fields."):
+		query.OrderExpr("custom_fields->>?"+dir, strings.TrimPrefix(field, "custom_fields."))
+	default:
+		query.OrderExpr("?"+dir, pg.F(field))
+	}
+}
+
+// CreateTodos inserts items in a single statement.
+func (r *Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	if len(items) == 0 {
+		return nil
+	}
+	now := types.TimestampNow()
+	for _, item := range items {
+		if item.CreatedAt == nil {
+			item.CreatedAt = now
+		}
+	}
+	return translate(r.run(ctx, func(db orm.DB) error {
+		return db.Insert(&items)
+	}))
+}
+
+// GetTodo returns the item with the given ID.
+func (r *Repository) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	var item todo.Todo
+	err := r.read(ctx, func(db orm.DB) error {
+		return db.Model(&item).Where("id = ?", id).First()
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return &item, nil
+}
+
+// LockTodos selects the given items FOR UPDATE.
+func (r *Repository) LockTodos(ctx context.Context, ids ...string) ([]*todo.Todo, error) {
+	var items []*todo.Todo
+	if len(ids) == 0 {
+		return items, nil
+	}
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&items).Where("id IN (?)", pg.In(ids)).For("UPDATE").Select()
+	})
+	return items, translate(err)
+}
+
+// ListTodos returns the items matching a filter.
+func (r *Repository) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	var items []*todo.Todo
+	err := r.read(ctx, func(db orm.DB) error {
+		query := db.Model(&items).Apply(filter(f))
+		order(query, f.Order)
+		if f.Limit > 0 {
+			query.Limit(f.Limit)
+		}
+		return query.Select()
+	})
+	return items, translate(err)
+}
+
+// CountTodos counts the items matching a filter.
+func (r *Repository) CountTodos(ctx context.Context, f storage.Filter) (int, error) {
+	var count int
+	err := r.read(ctx, func(db orm.DB) (err error) {
+		count, err = db.Model((*todo.Todo)(nil)).Apply(filter(f)).Count()
+		return err
+	})
+	return count, translate(err)
+}
+
+// UpdateTodos updates the mutable columns of items.
+func (r *Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.atomic(ctx, func(db orm.DB) error {
+		for _, item := range items {
+			_, err := db.Model(item).Column(updatedColumns...).WherePK().Update()
+			if err != nil {
+				return translate(err)
+			}
+		}
+		return nil
+	})
+}
+
+// AddTrackedSeconds increments the tracked seconds of an item.
+func (r *Repository) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	return translate(r.run(ctx, func(db orm.DB) error {
+		_, err := db.Model((*todo.Todo)(nil)).
+			Set("tracked_seconds = coalesce(tracked_seconds, 0) + ?", seconds).
+			Where("id = ?", id).
+			Update()
+		return err
+	}))
+}
+
+// DeleteTodos deletes the items matching a filter and their time entries.
+func (r *Repository) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	var deleted int
+	err := r.atomic(ctx, func(db orm.DB) error {
+		ids := db.Model((*todo.Todo)(nil)).Column("id").Apply(filter(f))
+		_, err := db.Model((*todo.TimeEntry)(nil)).Where("todo_id IN (?)", ids).Delete()
+		if err != nil {
+			return err
+		}
+		res, err := db.Model((*todo.Todo)(nil)).Apply(filter(f)).Delete()
+		if err != nil {
+			return err
+		}
+		deleted = res.RowsAffected()
+		return nil
+	})
+	return deleted, translate(err)
+}
+
+// CreateTimeEntry inserts a time entry.
+func (r *Repository) CreateTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	return translate(r.run(ctx, func(db orm.DB) error {
+		return db.Insert(entry)
+	}))
+}
+
+// RunningTimeEntry selects the running entry of a user FOR UPDATE.
+func (r *Repository) RunningTimeEntry(ctx context.Context, userID string) (*todo.TimeEntry, error) {
+	var entry todo.TimeEntry
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&entry).Where("user_id = ?", userID).Where("stopped_at IS NULL").For("UPDATE").First()
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return &entry, nil
+}
+
+// StopTimeEntry updates the stop date and duration of an entry.
+func (r *Repository) StopTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	return translate(r.run(ctx, func(db orm.DB) error {
+		_, err := db.Model(entry).Column("stopped_at", "duration_seconds").WherePK().Update()
+		return err
+	}))
+}
+
+// ListTimeEntries returns the entries of an item sorted by start date.
+func (r *Repository) ListTimeEntries(ctx context.Context, todoID string) ([]*todo.TimeEntry, error) {
+	var entries []*todo.TimeEntry
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&entries).
+			Where("todo_id = ?", todoID).
+			OrderExpr("(started_at->>'seconds')::bigint ASC, (started_at->>'nanos')::int ASC").
+			Select()
+	})
+	return entries, translate(err)
+}
+
+// TimeEntriesBetween returns the entries overlapping a date range.
+func (r *Repository) TimeEntriesBetween(ctx context.Context, from, to time.Time) ([]*todo.TimeEntry, error) {
+	// Timestamps are stored as JSON documents, compare their seconds
+	var entries []*todo.TimeEntry
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&entries).
+			Where("(started_at->>'seconds')::bigint < ?", to.Unix()).
+			WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+				return q.Where("stopped_at IS NULL").WhereOr("(stopped_at->>'seconds')::bigint >= ?", from.Unix()), nil
+			}).
+			Select()
+	})
+	return entries, translate(err)
+}
+
+// PutCustomFieldSchema upserts the schema of a list, in the tenant of ctx.
+func (r *Repository) PutCustomFieldSchema(ctx context.Context, schema *todo.CustomFieldSchema) error {
+	return translate(r.run(ctx, func(db orm.DB) error {
+		_, err := db.Model(schema).
+			OnConflict("((COALESCE(tenant_id, '')), list) DO UPDATE").
+			Set("schema = EXCLUDED.schema, updated_at = EXCLUDED.updated_at").
+			Insert()
+		return err
+	}))
+}
+
+// CustomFieldSchemas returns the schemas of the given lists.
+func (r *Repository) CustomFieldSchemas(ctx context.Context, lists ...string) ([]*todo.CustomFieldSchema, error) {
+	var schemas []*todo.CustomFieldSchema
+	if len(lists) == 0 {
+		return schemas, nil
+	}
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&schemas).Where("list IN (?)", pg.In(lists)).Select()
+	})
+	return schemas, translate(err)
+}
+
+// ListCustomFieldSchemas returns every schema sorted by list.
+func (r *Repository) ListCustomFieldSchemas(ctx context.Context) ([]*todo.CustomFieldSchema, error) {
+	var schemas []*todo.CustomFieldSchema
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&schemas).Order("list ASC").Select()
+	})
+	return schemas, translate(err)
+}
+
+// CreateTemplate inserts a template.
+func (r *Repository) CreateTemplate(ctx context.Context, template *todo.TodoTemplate) error {
+	return translate(r.run(ctx, func(db orm.DB) error {
+		return db.Insert(template)
+	}))
+}
+
+// GetTemplate returns the template with the given ID.
+func (r *Repository) GetTemplate(ctx context.Context, id string) (*todo.TodoTemplate, error) {
+	var template todo.TodoTemplate
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&template).Where("id = ?", id).First()
+	})
+	if err != nil {
+		return nil, translate(err)
+	}
+	return &template, nil
+}
+
+// ListTemplates returns every template sorted by name.
+func (r *Repository) ListTemplates(ctx context.Context) ([]*todo.TodoTemplate, error) {
+	var templates []*todo.TodoTemplate
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&templates).Order("name ASC").Select()
+	})
+	return templates, translate(err)
+}
+
+// DeleteTemplate deletes a template.
+func (r *Repository) DeleteTemplate(ctx context.Context, id string) error {
+	var deleted int
+	err := r.run(ctx, func(db orm.DB) error {
+		res, err := db.Model((*todo.TodoTemplate)(nil)).Where("id = ?", id).Delete()
+		if err != nil {
+			return err
+		}
+		deleted = res.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return translate(err)
+	}
+	if deleted == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// AppendEvents inserts events in a single statement, their IDs are
+// assigned by the sequence of the table.
+func (r *Repository) AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return translate(r.run(ctx, func(db orm.DB) error {
+		return db.Insert(&events)
+	}))
+}
+
+// ClaimEvents selects the oldest event of each item FOR UPDATE SKIP LOCKED,
+// so that concurrent relays claim distinct items.
+func (r *Repository) ClaimEvents(ctx context.Context, limit int) ([]*todo.TodoEvent, error) {
+	var events []*todo.TodoEvent
+	err := r.run(ctx, func(db orm.DB) error {
+		return db.Model(&events).
+			Where("NOT EXISTS (SELECT 1 FROM todo_events AS o WHERE o.todo_id = ?TableAlias.todo_id AND o.id < ?TableAlias.id)").
+			Order("id ASC").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Select()
+	})
+	return events, translate(err)
+}
+
+// DeleteEvents removes events from the outbox.
+func (r *Repository) DeleteEvents(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return translate(r.run(ctx, func(db orm.DB) error {
+		_, err := db.Model((*todo.TodoEvent)(nil)).Where("id IN (?)", pg.In(ids)).Delete()
+		return err
+	}))
+}
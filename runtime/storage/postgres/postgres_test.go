@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func connect() *pg.DB {
+	return pg.Connect(&pg.Options{
+		User:                  "postgres",
+		Database:              "todo",
+		Addr:                  "localhost:5432",
+		RetryStatementTimeout: true,
+		MaxRetries:            4,
+		MinRetryBackoff:       250 * time.Millisecond,
+	})
+}
+
+// TestConformance runs the suite against the database of TestTodoTestSuite.
+func TestConformance(t *testing.T) {
+	db := connect()
+	defer db.Close()
+	ctx := context.Background()
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository {
+			repo := New(db)
+			repo.Migrator().Down(ctx, len(Migrations))
+			if _, err := repo.Migrator().Up(ctx); err != nil {
+				t.Fatal(err)
+			}
+			return repo
+		},
+		Cleanup: func() { New(db).Migrator().Down(ctx, len(Migrations)) },
+	})
+}
+
+// TestMigrateBaseline adopts a todos table created by go-pg from the first
+// version of the Todo struct, before migrations existed.
+func TestMigrateBaseline(t *testing.T) {
+	db := connect()
+	defer db.Close()
+	ctx := context.Background()
+	repo := New(db)
+	repo.Migrator().Down(ctx, len(Migrations))
+	defer repo.Migrator().Down(ctx, len(Migrations))
+	for _, stmt := range []string{
+		"DROP TABLE IF EXISTS todos",
+		`CREATE TABLE todos (
+			id text,
+			title text,
+			description text,
+			completed boolean NOT NULL DEFAULT false,
+			created_at jsonb,
+			updated_at jsonb,
+			xxx__no_unkeyed_literal jsonb,
+			xxx_unrecognized bytea,
+			xxx_sizecache integer,
+			PRIMARY KEY (id)
+		)`,
+		`INSERT INTO todos (id, title, completed) VALUES ('old', 'baseline', true)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := repo.Migrator().Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var columns int
+	_, err := db.QueryOne(pg.Scan(&columns), "SELECT count(*) FROM information_schema.columns WHERE table_name = 'todos' AND column_name LIKE 'xxx%'")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, columns)
+
+	item, err := repo.GetTodo(ctx, "old")
+	assert.Nil(t, err)
+	assert.Equal(t, "baseline", item.Title)
+	assert.True(t, item.Completed)
+
+	due := &types.Timestamp{Seconds: 1000}
+	assert.Nil(t, repo.CreateTodos(ctx, &todo.Todo{
+		Id: "new", Title: "a", Status: "todo", Tags: []string{"x"}, List: "work", TrackedSeconds: 60,
+		CustomFields: map[string]string{"k": "v"}, ParentId: "old", DueAt: due, Recurrence: "FREQ=DAILY",
+	}))
+	item, err = repo.GetTodo(ctx, "new")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"x"}, item.Tags)
+	assert.Equal(t, "old", item.ParentId)
+	assert.Equal(t, due, item.DueAt)
+}
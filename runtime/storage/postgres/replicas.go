@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// lagQuery measures how far a replica is behind its primary. A replica
+// which replayed everything it received is up to date, even when the
+// primary has not written for a while.
+const lagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+END`
+
+// Replicas routes the reads allowed to be stale to the read replicas of the
+// primary database in turn. A replica is ejected while it cannot be reached
+// or lags behind the primary by more than the maximum lag, the reads go to
+// the primary when every replica is ejected.
+type Replicas struct {
+	maxLag time.Duration
+	pools  []*replica
+	next   uint32
+	// lag measures the replication lag of a replica.
+	lag   func(ctx context.Context, db *pg.DB) (time.Duration, error)
+	reads *prometheus.CounterVec
+}
+
+type replica struct {
+	name string
+	db   *pg.DB
+	// healthy is 1 when the replica serves reads, replicas are ejected
+	// until their first check.
+	healthy int32
+	lag     int64
+}
+
+// NewReplicas returns the replicas dbs, named replica-0, replica-1 and so on
+// in the metrics, ejected when they lag by more than maxLag.
+func NewReplicas(maxLag time.Duration, dbs ...*pg.DB) *Replicas {
+	r := &Replicas{
+		maxLag: maxLag,
+		lag:    replicationLag,
+		reads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gotasks_db_reads_total",
+			Help: "Number of the reads allowed to be stale served by each database pool.",
+		}, []string{"pool"}),
+	}
+	for i, db := range dbs {
+		r.pools = append(r.pools, &replica{name: fmt.Sprintf("replica-%d", i), db: db})
+	}
+	return r
+}
+
+// replicationLag runs lagQuery on db.
+func replicationLag(ctx context.Context, db *pg.DB) (time.Duration, error) {
+	var seconds *float64
+	if _, err := db.WithContext(ctx).QueryOne(pg.Scan(&seconds), lagQuery); err != nil {
+		return 0, err
+	}
+	if seconds == nil {
+		return 0, fmt.Errorf("replica has not replayed any transaction")
+	}
+	return time.Duration(*seconds * float64(time.Second)), nil
+}
+
+// Check measures the lag of every replica and ejects the ones which are
+// unreachable or lag by more than the maximum lag.
+func (r *Replicas) Check(ctx context.Context) {
+	for _, p := range r.pools {
+		lag, err := r.lag(ctx, p.db)
+		healthy := int32(0)
+		if err == nil && lag <= r.maxLag {
+			healthy = 1
+		}
+		atomic.StoreInt64(&p.lag, int64(lag))
+		atomic.StoreInt32(&p.healthy, healthy)
+	}
+}
+
+// Watch checks the replicas right away and then every interval until ctx is done.
+func (r *Replicas) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pick returns the next healthy replica, or nil when there is none.
+func (r *Replicas) pick() *pg.DB {
+	start := atomic.AddUint32(&r.next, 1)
+	for i := range r.pools {
+		p := r.pools[(int(start)+i)%len(r.pools)]
+		if atomic.LoadInt32(&p.healthy) == 1 {
+			r.reads.WithLabelValues(p.name).Inc()
+			return p.db
+		}
+	}
+	r.reads.WithLabelValues("primary").Inc()
+	return nil
+}
+
+// Close closes the connections to the replicas.
+func (r *Replicas) Close() error {
+	for _, p := range r.pools {
+		if err := p.db.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
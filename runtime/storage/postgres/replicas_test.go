@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicas(t *testing.T) {
+	// go-pg connects lazily, the databases are never reached
+	primary := pg.Connect(&pg.Options{Addr: "primary:5432"})
+	a := pg.Connect(&pg.Options{Addr: "a:5432"})
+	b := pg.Connect(&pg.Options{Addr: "b:5432"})
+	replicas := NewReplicas(time.Second, a, b)
+	lags := map[*pg.DB]time.Duration{a: 0, b: 0}
+	replicas.lag = func(ctx context.Context, db *pg.DB) (time.Duration, error) {
+		lag, ok := lags[db]
+		if !ok {
+			return 0, errors.New("unreachable")
+		}
+		return lag, nil
+	}
+	ctx := context.Background()
+
+	// Replicas are ejected until checked
+	assert.Nil(t, replicas.pick())
+	replicas.Check(ctx)
+	first, second := replicas.pick(), replicas.pick()
+	assert.ElementsMatch(t, []*pg.DB{a, b}, []*pg.DB{first, second})
+	assert.Equal(t, first, replicas.pick())
+
+	// A lagging replica is ejected, an unreachable one too
+	lags[a] = 2 * time.Second
+	replicas.Check(ctx)
+	assert.Equal(t, b, replicas.pick())
+	assert.Equal(t, b, replicas.pick())
+	delete(lags, b)
+	replicas.Check(ctx)
+	assert.Nil(t, replicas.pick())
+	assert.Equal(t, 2.0, testutil.ToFloat64(replicas.reads.WithLabelValues("primary")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(replicas.reads.WithLabelValues("replica-1")))
+
+	// Only the reads allowed to be stale go to the replicas
+	lags[a] = 0
+	replicas.Check(ctx)
+	repo := New(primary).WithReplicas(replicas)
+	assert.Equal(t, primary, repo.pool(ctx))
+	stale := storage.AllowStale(ctx)
+	assert.Equal(t, a, repo.pool(stale))
+	assert.Equal(t, 3.0, testutil.ToFloat64(replicas.reads.WithLabelValues("replica-0")))
+}
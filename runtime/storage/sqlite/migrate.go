@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+
+	"github.com/gofunct/gotasks/runtime/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migrations are the migrations of the SQLite schema.
+var Migrations = migrate.MustLoad(migrationFiles, "migrations")
+
+// Migrator returns the migrator of the schema of the database of r.
+func (r *Repository) Migrator() migrate.Migrator {
+	return migrate.Migrator{DB: database{r.db}, Migrations: Migrations}
+}
+
+type database struct {
+	db *sql.DB
+}
+
+// Locked runs fn in a transaction, which holds the write lock
+// of the database file from its beginning.
+func (d database) Locked(ctx context.Context, fn func(migrate.Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now'))
+	)`)
+	if err == nil {
+		err = fn(migrationTx{tx})
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Versions reads schema_migrations outside of a transaction.
+func (d database) Versions(ctx context.Context) ([]int, error) {
+	var exists bool
+	err := d.db.QueryRowContext(ctx, "SELECT count(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'").Scan(&exists)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return versions(ctx, d.db)
+}
+
+type migrationTx struct {
+	tx *sql.Tx
+}
+
+func (t migrationTx) Versions(ctx context.Context) ([]int, error) {
+	return versions(ctx, t.tx)
+}
+
+func versions(ctx context.Context, q querier) ([]int, error) {
+	rows, err := q.QueryContext(ctx, "SELECT version FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var versions []int
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+func (t migrationTx) Exec(ctx context.Context, script string) error {
+	_, err := t.tx.ExecContext(ctx, script)
+	return err
+}
+
+func (t migrationTx) Insert(ctx context.Context, m migrate.Migration) error {
+	_, err := t.tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES (?, ?)", m.Version, m.Name)
+	return err
+}
+
+func (t migrationTx) Delete(ctx context.Context, version int) error {
+	_, err := t.tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", version)
+	return err
+}
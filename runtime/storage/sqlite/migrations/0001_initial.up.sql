@@ -0,0 +1,41 @@
+-- Timestamps are stored as nanoseconds since the epoch, tags, custom fields
+-- and template items as JSON documents.
+CREATE TABLE IF NOT EXISTS todos (
+	id TEXT PRIMARY KEY,
+	title TEXT,
+	description TEXT,
+	completed INTEGER NOT NULL DEFAULT 0,
+	created_at INTEGER,
+	updated_at INTEGER,
+	status TEXT,
+	tags TEXT,
+	list TEXT,
+	tracked_seconds INTEGER NOT NULL DEFAULT 0,
+	custom_fields TEXT,
+	parent_id TEXT,
+	due_at INTEGER,
+	recurrence TEXT
+);
+CREATE INDEX IF NOT EXISTS todos_parent_id ON todos (parent_id);
+CREATE TABLE IF NOT EXISTS time_entries (
+	id TEXT PRIMARY KEY,
+	todo_id TEXT,
+	user_id TEXT,
+	started_at INTEGER,
+	stopped_at INTEGER,
+	duration_seconds INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS time_entries_todo_id ON time_entries (todo_id);
+CREATE UNIQUE INDEX IF NOT EXISTS time_entries_running_user_id ON time_entries (user_id) WHERE stopped_at IS NULL;
+CREATE TABLE IF NOT EXISTS custom_field_schemas (
+	list TEXT PRIMARY KEY,
+	schema TEXT,
+	updated_at INTEGER
+);
+CREATE TABLE IF NOT EXISTS todo_templates (
+	id TEXT PRIMARY KEY,
+	name TEXT,
+	list TEXT,
+	item TEXT,
+	created_at INTEGER
+);
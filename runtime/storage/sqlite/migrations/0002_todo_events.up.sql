@@ -0,0 +1,9 @@
+-- Outbox of the changes of the todos, published by the relay.
+CREATE TABLE todo_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	todo_id TEXT,
+	type INTEGER NOT NULL DEFAULT 0,
+	item TEXT,
+	created_at INTEGER
+);
+CREATE INDEX todo_events_todo_id ON todo_events (todo_id, id);
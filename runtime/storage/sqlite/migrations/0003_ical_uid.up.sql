@@ -0,0 +1,2 @@
+-- UID of the iCalendar VTODO of the imported items
+ALTER TABLE todos ADD COLUMN ical_uid TEXT;
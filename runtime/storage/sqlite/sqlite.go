@@ -0,0 +1,616 @@
+// Package sqlite stores the records of the todo service in a SQLite
+// database file, for edge deployments and local development.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/types"
+	"github.com/mattn/go-sqlite3"
+)
+
+const todoColumns = "id, title, description, completed, created_at, updated_at, status, tags, list, tracked_seconds, custom_fields, parent_id, due_at, recurrence, ical_uid"
+
+const timeEntryColumns = "id, todo_id, user_id, started_at, stopped_at, duration_seconds"
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Repository is a storage.Repository backed by SQLite.
+type Repository struct {
+	db *sql.DB
+	// tx is set for the repositories passed to Transaction.
+	tx *sql.Tx
+}
+
+// Open opens the database file at path, creating it when it does not exist.
+// Its tables are created by the migrations. Transactions take the write lock
+// when they begin, so that the items they read stay locked until they end.
+func Open(path string) (*Repository, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?"+url.Values{
+		"_busy_timeout": {"5000"},
+		"_txlock":       {"immediate"},
+	}.Encode())
+	if err != nil {
+		return nil, err
+	}
+	return &Repository{db: db}, nil
+}
+
+// Close closes the database.
+func (r *Repository) Close() error {
+	return r.db.Close()
+}
+
+// Ping checks that the database file can be read.
+func (r *Repository) Ping(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, "SELECT count(*) FROM sqlite_master")
+	return err
+}
+
+func (r *Repository) conn() querier {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.db
+}
+
+// atomic runs fn in the transaction of the repository or in a new one.
+func (r *Repository) atomic(ctx context.Context, fn func(querier) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return translate(err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return translate(tx.Commit())
+}
+
+// Transaction runs fn in a database transaction.
+func (r *Repository) Transaction(ctx context.Context, fn func(storage.Repository) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+	return r.atomic(ctx, func(q querier) error {
+		return fn(&Repository{db: r.db, tx: q.(*sql.Tx)})
+	})
+}
+
+// translate maps the errors of the driver to the errors of the storage package.
+func translate(err error) error {
+	if err == sql.ErrNoRows {
+		return storage.ErrNotFound
+	}
+	if e, ok := err.(sqlite3.Error); ok && e.Code == sqlite3.ErrConstraint &&
+		(e.ExtendedCode == sqlite3.ErrConstraintUnique || e.ExtendedCode == sqlite3.ErrConstraintPrimaryKey) {
+		return storage.ErrAlreadyExists
+	}
+	return err
+}
+
+// nanos converts a timestamp to the value stored in the database.
+func nanos(ts *types.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+	return ts.Seconds*int64(time.Second) + int64(ts.Nanos)
+}
+
+func timestamp(n sql.NullInt64) *types.Timestamp {
+	if !n.Valid {
+		return nil
+	}
+	sec, nsec := n.Int64/int64(time.Second), n.Int64%int64(time.Second)
+	if nsec < 0 {
+		sec, nsec = sec-1, nsec+int64(time.Second)
+	}
+	return &types.Timestamp{Seconds: sec, Nanos: int32(nsec)}
+}
+
+// text stores empty strings as NULL, as go-pg does.
+func text(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// document encodes a value as JSON, empty values are stored as NULL.
+func document(v interface{}, empty bool) (interface{}, error) {
+	if empty {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func decode(s sql.NullString, v interface{}) error {
+	if !s.Valid {
+		return nil
+	}
+	return json.Unmarshal([]byte(s.String), v)
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTodo(row scanner) (*todo.Todo, error) {
+	var item todo.Todo
+	var title, description, status, tags, list, customFields, parentID, recurrence, icalUID sql.NullString
+	var createdAt, updatedAt, dueAt sql.NullInt64
+	err := row.Scan(&item.Id, &title, &description, &item.Completed, &createdAt, &updatedAt, &status,
+		&tags, &list, &item.TrackedSeconds, &customFields, &parentID, &dueAt, &recurrence, &icalUID)
+	if err != nil {
+		return nil, err
+	}
+	item.Title, item.Description, item.Status = title.String, description.String, status.String
+	item.List, item.ParentId, item.Recurrence, item.IcalUid = list.String, parentID.String, recurrence.String, icalUID.String
+	item.CreatedAt, item.UpdatedAt, item.DueAt = timestamp(createdAt), timestamp(updatedAt), timestamp(dueAt)
+	if err := decode(tags, &item.Tags); err != nil {
+		return nil, err
+	}
+	if err := decode(customFields, &item.CustomFields); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *Repository) queryTodos(ctx context.Context, query string, args ...interface{}) ([]*todo.Todo, error) {
+	rows, err := r.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, translate(err)
+	}
+	defer rows.Close()
+	var items []*todo.Todo
+	for rows.Next() {
+		item, err := scanTodo(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, translate(rows.Err())
+}
+
+// in returns the condition restricting a column to values.
+func in(column string, values []string) (string, []interface{}) {
+	if len(values) == 0 {
+		return "0", nil
+	}
+	args := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return column + " IN (?" + strings.Repeat(", ?", len(values)-1) + ")", args
+}
+
+// where returns the WHERE clause selecting the items matching f.
+func where(f storage.Filter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, a ...interface{}) {
+		conds = append(conds, cond)
+		args = append(args, a...)
+	}
+	if f.IDs != nil {
+		cond, a := in("id", f.IDs)
+		add(cond, a...)
+	}
+	if f.ParentIDs != nil {
+		cond, a := in("parent_id", f.ParentIDs)
+		add(cond, a...)
+	}
+	if f.NotCompleted {
+		add("completed = 0")
+	}
+	if f.OnlyCompleted {
+		add("completed = 1")
+	}
+	if f.Status != "" {
+		add("status = ?", f.Status)
+	}
+	if f.List != "" {
+		add("list = ?", f.List)
+	}
+	for _, kv := range f.CustomFields {
+		add("json_extract(custom_fields, ?) = ?", "$."+kv[0], kv[1])
+	}
+	if f.AfterID != "" {
+		add("id > ?", f.AfterID)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// orderBy returns the ORDER BY clause of an order, ties are broken
+// by insertion order.
+func orderBy(o storage.Order) (string, []interface{}) {
+	dir := " ASC NULLS LAST"
+	if o.Desc {
+		dir = " DESC NULLS FIRST"
+	}
+	var args []interface{}
+	expr := o.Field
+	switch {
+	case expr == "":
+		expr = "created_at"
+	case strings.HasPrefix(expr, "custom_fields."):
+		expr = "json_extract(custom_fields, ?)"
+		args = append(args, "$."+strings.TrimPrefix(o.Field, "custom_fields."))
+	case expr != "id" && expr != "title" && expr != "status" && expr != "created_at" && expr != "updated_at":
+		expr = "created_at"
+	}
+	return " ORDER BY " + expr + dir + ", rowid ASC", args
+}
+
+// CreateTodos inserts items in a single statement.
+func (r *Repository) CreateTodos(ctx context.Context, items ...*todo.Todo) error {
+	if len(items) == 0 {
+		return nil
+	}
+	now := types.TimestampNow()
+	values := make([]string, 0, len(items))
+	var args []interface{}
+	for _, item := range items {
+		if item.CreatedAt == nil {
+			item.CreatedAt = now
+		}
+		tags, err := document(item.Tags, len(item.Tags) == 0)
+		if err != nil {
+			return err
+		}
+		fields, err := document(item.CustomFields, len(item.CustomFields) == 0)
+		if err != nil {
+			return err
+		}
+		values = append(values, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, item.Id, text(item.Title), text(item.Description), item.Completed,
+			nanos(item.CreatedAt), nanos(item.UpdatedAt), text(item.Status), tags, text(item.List),
+			item.TrackedSeconds, fields, text(item.ParentId), nanos(item.DueAt), text(item.Recurrence), text(item.IcalUid))
+	}
+	_, err := r.conn().ExecContext(ctx, "INSERT INTO todos ("+todoColumns+") VALUES "+strings.Join(values, ", "), args...)
+	return translate(err)
+}
+
+// GetTodo returns the item with the given ID.
+func (r *Repository) GetTodo(ctx context.Context, id string) (*todo.Todo, error) {
+	item, err := scanTodo(r.conn().QueryRowContext(ctx, "SELECT "+todoColumns+" FROM todos WHERE id = ?", id))
+	if err != nil {
+		return nil, translate(err)
+	}
+	return item, nil
+}
+
+// LockTodos returns the given items, the transaction
+// already holds the write lock of the database.
+func (r *Repository) LockTodos(ctx context.Context, ids ...string) ([]*todo.Todo, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	cond, args := in("id", ids)
+	return r.queryTodos(ctx, "SELECT "+todoColumns+" FROM todos WHERE "+cond, args...)
+}
+
+// ListTodos returns the items matching a filter.
+func (r *Repository) ListTodos(ctx context.Context, f storage.Filter) ([]*todo.Todo, error) {
+	w, args := where(f)
+	order, orderArgs := orderBy(f.Order)
+	query := "SELECT " + todoColumns + " FROM todos" + w + order
+	args = append(args, orderArgs...)
+	if f.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, f.Limit)
+	}
+	return r.queryTodos(ctx, query, args...)
+}
+
+// CountTodos counts the items matching a filter.
+func (r *Repository) CountTodos(ctx context.Context, f storage.Filter) (int, error) {
+	w, args := where(f)
+	var count int
+	err := r.conn().QueryRowContext(ctx, "SELECT count(*) FROM todos"+w, args...).Scan(&count)
+	return count, translate(err)
+}
+
+// UpdateTodos updates the mutable columns of items.
+func (r *Repository) UpdateTodos(ctx context.Context, items ...*todo.Todo) error {
+	return r.atomic(ctx, func(q querier) error {
+		for _, item := range items {
+			tags, err := document(item.Tags, len(item.Tags) == 0)
+			if err != nil {
+				return err
+			}
+			fields, err := document(item.CustomFields, len(item.CustomFields) == 0)
+			if err != nil {
+				return err
+			}
+			_, err = q.ExecContext(ctx, `UPDATE todos SET title = ?, description = ?, completed = ?, status = ?, tags = ?,
+				list = ?, custom_fields = ?, due_at = ?, recurrence = ?, updated_at = ? WHERE id = ?`,
+				text(item.Title), text(item.Description), item.Completed, text(item.Status), tags,
+				text(item.List), fields, nanos(item.DueAt), text(item.Recurrence), nanos(item.UpdatedAt), item.Id)
+			if err != nil {
+				return translate(err)
+			}
+		}
+		return nil
+	})
+}
+
+// AddTrackedSeconds increments the tracked seconds of an item.
+func (r *Repository) AddTrackedSeconds(ctx context.Context, id string, seconds int64) error {
+	_, err := r.conn().ExecContext(ctx, "UPDATE todos SET tracked_seconds = tracked_seconds + ? WHERE id = ?", seconds, id)
+	return translate(err)
+}
+
+// DeleteTodos deletes the items matching a filter and their time entries.
+func (r *Repository) DeleteTodos(ctx context.Context, f storage.Filter) (int, error) {
+	w, args := where(f)
+	var deleted int64
+	err := r.atomic(ctx, func(q querier) error {
+		_, err := q.ExecContext(ctx, "DELETE FROM time_entries WHERE todo_id IN (SELECT id FROM todos"+w+")", args...)
+		if err != nil {
+			return translate(err)
+		}
+		res, err := q.ExecContext(ctx, "DELETE FROM todos"+w, args...)
+		if err != nil {
+			return translate(err)
+		}
+		deleted, err = res.RowsAffected()
+		return err
+	})
+	return int(deleted), err
+}
+
+func scanTimeEntry(row scanner) (*todo.TimeEntry, error) {
+	var entry todo.TimeEntry
+	var startedAt, stoppedAt sql.NullInt64
+	err := row.Scan(&entry.Id, &entry.TodoId, &entry.UserId, &startedAt, &stoppedAt, &entry.DurationSeconds)
+	if err != nil {
+		return nil, err
+	}
+	entry.StartedAt, entry.StoppedAt = timestamp(startedAt), timestamp(stoppedAt)
+	return &entry, nil
+}
+
+func (r *Repository) queryTimeEntries(ctx context.Context, query string, args ...interface{}) ([]*todo.TimeEntry, error) {
+	rows, err := r.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, translate(err)
+	}
+	defer rows.Close()
+	var entries []*todo.TimeEntry
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, translate(rows.Err())
+}
+
+// CreateTimeEntry inserts a time entry.
+func (r *Repository) CreateTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	_, err := r.conn().ExecContext(ctx, "INSERT INTO time_entries ("+timeEntryColumns+") VALUES (?, ?, ?, ?, ?, ?)",
+		entry.Id, entry.TodoId, entry.UserId, nanos(entry.StartedAt), nanos(entry.StoppedAt), entry.DurationSeconds)
+	return translate(err)
+}
+
+// RunningTimeEntry returns the running entry of a user.
+func (r *Repository) RunningTimeEntry(ctx context.Context, userID string) (*todo.TimeEntry, error) {
+	row := r.conn().QueryRowContext(ctx, "SELECT "+timeEntryColumns+" FROM time_entries WHERE user_id = ? AND stopped_at IS NULL", userID)
+	entry, err := scanTimeEntry(row)
+	if err != nil {
+		return nil, translate(err)
+	}
+	return entry, nil
+}
+
+// StopTimeEntry updates the stop date and duration of an entry.
+func (r *Repository) StopTimeEntry(ctx context.Context, entry *todo.TimeEntry) error {
+	_, err := r.conn().ExecContext(ctx, "UPDATE time_entries SET stopped_at = ?, duration_seconds = ? WHERE id = ?",
+		nanos(entry.StoppedAt), entry.DurationSeconds, entry.Id)
+	return translate(err)
+}
+
+// ListTimeEntries returns the entries of an item sorted by start date.
+func (r *Repository) ListTimeEntries(ctx context.Context, todoID string) ([]*todo.TimeEntry, error) {
+	return r.queryTimeEntries(ctx, "SELECT "+timeEntryColumns+" FROM time_entries WHERE todo_id = ? ORDER BY started_at ASC, id ASC", todoID)
+}
+
+// TimeEntriesBetween returns the entries overlapping a date range.
+func (r *Repository) TimeEntriesBetween(ctx context.Context, from, to time.Time) ([]*todo.TimeEntry, error) {
+	return r.queryTimeEntries(ctx, "SELECT "+timeEntryColumns+" FROM time_entries WHERE started_at < ? AND (stopped_at IS NULL OR stopped_at >= ?) ORDER BY started_at ASC, id ASC",
+		to.UnixNano(), from.UnixNano())
+}
+
+// PutCustomFieldSchema upserts the schema of a list.
+func (r *Repository) PutCustomFieldSchema(ctx context.Context, schema *todo.CustomFieldSchema) error {
+	_, err := r.conn().ExecContext(ctx, `INSERT INTO custom_field_schemas (list, schema, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT (list) DO UPDATE SET schema = excluded.schema, updated_at = excluded.updated_at`,
+		schema.List, schema.Schema, nanos(schema.UpdatedAt))
+	return translate(err)
+}
+
+// CustomFieldSchemas returns the schemas of the given lists.
+func (r *Repository) CustomFieldSchemas(ctx context.Context, lists ...string) ([]*todo.CustomFieldSchema, error) {
+	if len(lists) == 0 {
+		return nil, nil
+	}
+	cond, args := in("list", lists)
+	return r.schemas(ctx, " WHERE "+cond, args...)
+}
+
+// ListCustomFieldSchemas returns every schema sorted by list.
+func (r *Repository) ListCustomFieldSchemas(ctx context.Context) ([]*todo.CustomFieldSchema, error) {
+	return r.schemas(ctx, " ORDER BY list ASC")
+}
+
+// schemas returns the schemas selected by the clauses of a query.
+func (r *Repository) schemas(ctx context.Context, clauses string, args ...interface{}) ([]*todo.CustomFieldSchema, error) {
+	rows, err := r.conn().QueryContext(ctx, "SELECT list, schema, updated_at FROM custom_field_schemas"+clauses, args...)
+	if err != nil {
+		return nil, translate(err)
+	}
+	defer rows.Close()
+	var schemas []*todo.CustomFieldSchema
+	for rows.Next() {
+		var schema todo.CustomFieldSchema
+		var updatedAt sql.NullInt64
+		if err := rows.Scan(&schema.List, &schema.Schema, &updatedAt); err != nil {
+			return nil, err
+		}
+		schema.UpdatedAt = timestamp(updatedAt)
+		schemas = append(schemas, &schema)
+	}
+	return schemas, translate(rows.Err())
+}
+
+func scanTemplate(row scanner) (*todo.TodoTemplate, error) {
+	var template todo.TodoTemplate
+	var name, list, item sql.NullString
+	var createdAt sql.NullInt64
+	if err := row.Scan(&template.Id, &name, &list, &item, &createdAt); err != nil {
+		return nil, err
+	}
+	template.Name, template.List, template.CreatedAt = name.String, list.String, timestamp(createdAt)
+	if err := decode(item, &template.Item); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// CreateTemplate inserts a template.
+func (r *Repository) CreateTemplate(ctx context.Context, template *todo.TodoTemplate) error {
+	item, err := document(template.Item, template.Item == nil)
+	if err != nil {
+		return err
+	}
+	_, err = r.conn().ExecContext(ctx, "INSERT INTO todo_templates (id, name, list, item, created_at) VALUES (?, ?, ?, ?, ?)",
+		template.Id, text(template.Name), text(template.List), item, nanos(template.CreatedAt))
+	return translate(err)
+}
+
+// GetTemplate returns the template with the given ID.
+func (r *Repository) GetTemplate(ctx context.Context, id string) (*todo.TodoTemplate, error) {
+	row := r.conn().QueryRowContext(ctx, "SELECT id, name, list, item, created_at FROM todo_templates WHERE id = ?", id)
+	template, err := scanTemplate(row)
+	if err != nil {
+		return nil, translate(err)
+	}
+	return template, nil
+}
+
+// ListTemplates returns every template sorted by name.
+func (r *Repository) ListTemplates(ctx context.Context) ([]*todo.TodoTemplate, error) {
+	rows, err := r.conn().QueryContext(ctx, "SELECT id, name, list, item, created_at FROM todo_templates ORDER BY name ASC, id ASC")
+	if err != nil {
+		return nil, translate(err)
+	}
+	defer rows.Close()
+	var templates []*todo.TodoTemplate
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, translate(rows.Err())
+}
+
+// DeleteTemplate deletes a template.
+func (r *Repository) DeleteTemplate(ctx context.Context, id string) error {
+	res, err := r.conn().ExecContext(ctx, "DELETE FROM todo_templates WHERE id = ?", id)
+	if err != nil {
+		return translate(err)
+	}
+	n, err := res.RowsAffected()
+	if err == nil && n == 0 {
+		return storage.ErrNotFound
+	}
+	return err
+}
+
+// AppendEvents inserts events, their IDs are assigned by the database.
+func (r *Repository) AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error {
+	return r.atomic(ctx, func(q querier) error {
+		for _, event := range events {
+			item, err := document(event.Item, event.Item == nil)
+			if err != nil {
+				return err
+			}
+			res, err := q.ExecContext(ctx, "INSERT INTO todo_events (todo_id, type, item, created_at) VALUES (?, ?, ?, ?)",
+				text(event.TodoId), int32(event.Type), item, nanos(event.CreatedAt))
+			if err != nil {
+				return translate(err)
+			}
+			if event.Id, err = res.LastInsertId(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ClaimEvents returns the oldest event of each item. Transactions take the
+// write lock of the database, so that the events stay locked.
+func (r *Repository) ClaimEvents(ctx context.Context, limit int) ([]*todo.TodoEvent, error) {
+	rows, err := r.conn().QueryContext(ctx, `SELECT id, todo_id, type, item, created_at FROM todo_events AS e
+		WHERE NOT EXISTS (SELECT 1 FROM todo_events AS o WHERE o.todo_id = e.todo_id AND o.id < e.id)
+		ORDER BY id LIMIT ?`, limit)
+	if err != nil {
+		return nil, translate(err)
+	}
+	defer rows.Close()
+	var events []*todo.TodoEvent
+	for rows.Next() {
+		var event todo.TodoEvent
+		var todoID, item sql.NullString
+		var createdAt sql.NullInt64
+		if err := rows.Scan(&event.Id, &todoID, &event.Type, &item, &createdAt); err != nil {
+			return nil, err
+		}
+		event.TodoId, event.CreatedAt = todoID.String, timestamp(createdAt)
+		if err := decode(item, &event.Item); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, translate(rows.Err())
+}
+
+// DeleteEvents removes events from the outbox.
+func (r *Repository) DeleteEvents(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	_, err := r.conn().ExecContext(ctx, "DELETE FROM todo_events WHERE id IN (?"+strings.Repeat(", ?", len(ids)-1)+")", args...)
+	return translate(err)
+}
@@ -0,0 +1,95 @@
+package sqlite
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gofunct/gotasks/runtime/storage/storagetest"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func open(t *testing.T) (*Repository, func()) {
+	dir, err := ioutil.TempDir("", "gotasks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := Open(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Migrator().Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return r, func() {
+		r.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	r, done := open(t)
+	defer done()
+	ctx := context.Background()
+	assert.Nil(t, r.Ping(ctx))
+	item := &todo.Todo{
+		Id:           "1",
+		Title:        "title",
+		Tags:         []string{"a", "b"},
+		CustomFields: map[string]string{"pri": "A"},
+		DueAt:        &types.Timestamp{Seconds: -1, Nanos: 5},
+		Recurrence:   "FREQ=DAILY",
+	}
+	assert.Nil(t, r.CreateTodos(ctx, item))
+	assert.NotNil(t, item.CreatedAt)
+
+	got, err := r.GetTodo(ctx, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, item, got)
+	_, err = r.GetTodo(ctx, "2")
+	assert.Equal(t, storage.ErrNotFound, err)
+	assert.Equal(t, storage.ErrAlreadyExists, r.CreateTodos(ctx, &todo.Todo{Id: "1"}))
+}
+
+// TestStatusReadOnly reads the status of a database which was never
+// migrated without creating schema_migrations.
+func TestStatusReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotasks")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	r, err := Open(filepath.Join(dir, "test.db"))
+	assert.Nil(t, err)
+	defer r.Close()
+	ctx := context.Background()
+
+	status, err := r.Migrator().Status(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, status, len(Migrations))
+	assert.False(t, status[0].Applied)
+	assert.Error(t, r.Migrator().Check(ctx))
+	var tables int
+	assert.Nil(t, r.db.QueryRow("SELECT count(*) FROM sqlite_master WHERE name = 'schema_migrations'").Scan(&tables))
+	assert.Equal(t, 0, tables)
+
+	_, err = r.Migrator().Up(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Migrator().Check(ctx))
+}
+
+func TestConformance(t *testing.T) {
+	var done func()
+	suite.Run(t, &storagetest.Suite{
+		NewRepo: func(t *testing.T) storage.Repository {
+			var r *Repository
+			r, done = open(t)
+			return r
+		},
+		Cleanup: func() { done() },
+	})
+}
@@ -0,0 +1,126 @@
+// Package storage defines the repository the todo service keeps its todo
+// items, time entries, custom field schemas and templates in, so that the
+// service does not depend on a particular database.
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+)
+
+var (
+	// ErrNotFound is returned when the requested record does not exist.
+	ErrNotFound = errors.New("storage: not found")
+	// ErrAlreadyExists is returned when a record conflicts with an existing
+	// one, such as a second running timer for the same user.
+	ErrAlreadyExists = errors.New("storage: already exists")
+)
+
+// Filter selects todo items. Conditions are combined with AND,
+// zero fields do not restrict the selection.
+type Filter struct {
+	// IDs restricts the selection to the given items when not nil,
+	// an empty non nil slice selects nothing.
+	IDs []string
+	// ParentIDs restricts the selection to the subtasks of the given
+	// items when not nil, an empty non nil slice selects nothing.
+	ParentIDs     []string
+	NotCompleted  bool
+	OnlyCompleted bool
+	Status        string
+	List          string
+	// CustomFields holds key/value pairs the custom fields must equal.
+	CustomFields [][2]string
+	// AfterID restricts the selection to the items whose ID sorts after it
+	// when not empty, to page through the items sorted by id.
+	AfterID string
+	// Order sorts the items, by creation date when empty.
+	Order Order
+	// Limit caps the number of items when positive.
+	Limit int
+}
+
+// Order sorts todo items by a field, NULL values come last in ascending
+// order and first in descending order.
+type Order struct {
+	// Field is id, title, status, created_at, updated_at or
+	// custom_fields.<key> to sort by a custom field.
+	Field string
+	Desc  bool
+}
+
+// Repository stores the records of the todo service. Each method is atomic,
+// Transaction groups several of them. Records passed to and returned by a
+// repository are copies, modifying them does not change the stored records.
+type Repository interface {
+	// Transaction runs fn with a repository whose operations are applied
+	// together, they are rolled back when fn returns an error which is
+	// then returned as is.
+	Transaction(ctx context.Context, fn func(Repository) error) error
+
+	// CreateTodos inserts todo items with their IDs already set. Items
+	// without creation date get the current time, as the column default
+	// of the SQL backends.
+	CreateTodos(ctx context.Context, items ...*todo.Todo) error
+	// GetTodo returns the item with the given ID or ErrNotFound.
+	GetTodo(ctx context.Context, id string) (*todo.Todo, error)
+	// LockTodos returns the existing items among the given IDs and keeps
+	// them from being modified by other transactions until the end of the
+	// current one.
+	LockTodos(ctx context.Context, ids ...string) ([]*todo.Todo, error)
+	ListTodos(ctx context.Context, filter Filter) ([]*todo.Todo, error)
+	CountTodos(ctx context.Context, filter Filter) (int, error)
+	// UpdateTodos writes the title, description, completed flag, status,
+	// tags, list, custom fields, due date, recurrence and update date of
+	// existing items. Unknown items are ignored.
+	UpdateTodos(ctx context.Context, items ...*todo.Todo) error
+	// AddTrackedSeconds adds seconds to the time tracked on an item.
+	AddTrackedSeconds(ctx context.Context, id string, seconds int64) error
+	// DeleteTodos deletes the items matching a filter along with their
+	// time entries and returns the number of deleted items.
+	DeleteTodos(ctx context.Context, filter Filter) (int, error)
+
+	// CreateTimeEntry inserts a time entry, it returns ErrAlreadyExists
+	// when a running entry is inserted for a user who already has one.
+	CreateTimeEntry(ctx context.Context, entry *todo.TimeEntry) error
+	// RunningTimeEntry returns the running entry of a user or ErrNotFound.
+	// The entry is locked until the end of the current transaction.
+	RunningTimeEntry(ctx context.Context, userID string) (*todo.TimeEntry, error)
+	// StopTimeEntry writes the stop date and duration of an entry.
+	StopTimeEntry(ctx context.Context, entry *todo.TimeEntry) error
+	// ListTimeEntries returns the entries of an item sorted by start date.
+	ListTimeEntries(ctx context.Context, todoID string) ([]*todo.TimeEntry, error)
+	// TimeEntriesBetween returns the entries started before to and stopped
+	// after from, running entries included.
+	TimeEntriesBetween(ctx context.Context, from, to time.Time) ([]*todo.TimeEntry, error)
+
+	// PutCustomFieldSchema inserts the schema of a list or replaces it.
+	PutCustomFieldSchema(ctx context.Context, schema *todo.CustomFieldSchema) error
+	// CustomFieldSchemas returns the schemas registered for the given lists.
+	CustomFieldSchemas(ctx context.Context, lists ...string) ([]*todo.CustomFieldSchema, error)
+	// ListCustomFieldSchemas returns every schema sorted by list.
+	ListCustomFieldSchemas(ctx context.Context) ([]*todo.CustomFieldSchema, error)
+
+	// CreateTemplate inserts a template with its ID already set.
+	CreateTemplate(ctx context.Context, template *todo.TodoTemplate) error
+	// GetTemplate returns the template with the given ID or ErrNotFound.
+	GetTemplate(ctx context.Context, id string) (*todo.TodoTemplate, error)
+	// ListTemplates returns every template sorted by name.
+	ListTemplates(ctx context.Context) ([]*todo.TodoTemplate, error)
+	// DeleteTemplate deletes a template or returns ErrNotFound.
+	DeleteTemplate(ctx context.Context, id string) error
+
+	// AppendEvents adds events to the outbox and sets their IDs, which
+	// increase in the order the events are appended.
+	AppendEvents(ctx context.Context, events ...*todo.TodoEvent) error
+	// ClaimEvents returns up to limit events of the outbox sorted by ID,
+	// only the oldest event of each todo item so that the events of an
+	// item are published in order. The events are locked until the end of
+	// the current transaction, other transactions skip them.
+	ClaimEvents(ctx context.Context, limit int) ([]*todo.TodoEvent, error)
+	// DeleteEvents removes events from the outbox.
+	DeleteEvents(ctx context.Context, ids ...int64) error
+}
@@ -0,0 +1,454 @@
+// Package storagetest is a conformance suite of the storage.Repository
+// contract, which each backend and decorator runs from its own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		suite.Run(t, &storagetest.Suite{
+//			NewRepo: func(t *testing.T) storage.Repository { return memory.New() },
+//		})
+//	}
+//
+// The timestamps of the suite have a microsecond precision, the precision
+// of PostgreSQL.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// Suite runs the conformance tests against the repositories of NewRepo.
+type Suite struct {
+	suite.Suite
+	// NewRepo returns an empty repository for each test, t is the test.
+	NewRepo func(t *testing.T) storage.Repository
+	// Cleanup releases the repository of a test, when set.
+	Cleanup func()
+	// Concurrency is the number of concurrent transactions of
+	// TestConcurrentUpdates, 8 when zero.
+	Concurrency int
+
+	Repo storage.Repository
+}
+
+func (s *Suite) SetupTest() {
+	s.Repo = s.NewRepo(s.T())
+}
+
+func (s *Suite) TearDownTest() {
+	if s.Cleanup != nil {
+		s.Cleanup()
+	}
+}
+
+// at returns a timestamp of the given second.
+func at(seconds int64) *types.Timestamp {
+	return &types.Timestamp{Seconds: 1500000000 + seconds, Nanos: 123000}
+}
+
+// ids returns the IDs of the items listed with f.
+func (s *Suite) ids(f storage.Filter) []string {
+	items, err := s.Repo.ListTodos(context.Background(), f)
+	s.Nil(err)
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func (s *Suite) TestCreateTodos() {
+	ctx := context.Background()
+	item := &todo.Todo{
+		Id:           "1",
+		Title:        "title",
+		Description:  "description",
+		CreatedAt:    at(0),
+		Status:       "doing",
+		Tags:         []string{"a", "b"},
+		List:         "work",
+		CustomFields: map[string]string{"pri": "A"},
+		DueAt:        at(3600),
+		Recurrence:   "FREQ=DAILY",
+	}
+	s.Nil(s.Repo.CreateTodos(ctx, item, &todo.Todo{Id: "2", Title: "subtask", ParentId: "1"}))
+
+	got, err := s.Repo.GetTodo(ctx, "1")
+	s.Nil(err)
+	s.Equal(item, got)
+	got, err = s.Repo.GetTodo(ctx, "2")
+	s.Nil(err)
+	s.Equal("1", got.ParentId)
+	s.NotNil(got.CreatedAt, "items without creation date get the current time")
+}
+
+func (s *Suite) TestCreateTodosConflict() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "first"}))
+	s.Equal(storage.ErrAlreadyExists, s.Repo.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "second"}))
+	// The items of a failed call are not inserted
+	s.Equal(storage.ErrAlreadyExists, s.Repo.CreateTodos(ctx, &todo.Todo{Id: "2"}, &todo.Todo{Id: "1"}))
+	s.Equal(storage.ErrAlreadyExists, s.Repo.CreateTodos(ctx, &todo.Todo{Id: "3"}, &todo.Todo{Id: "3"}))
+
+	s.Equal([]string{"1"}, s.ids(storage.Filter{}))
+	item, err := s.Repo.GetTodo(ctx, "1")
+	s.Nil(err)
+	s.Equal("first", item.Title)
+}
+
+func (s *Suite) TestNotFound() {
+	ctx := context.Background()
+	_, err := s.Repo.GetTodo(ctx, "missing")
+	s.Equal(storage.ErrNotFound, err)
+	items, err := s.Repo.LockTodos(ctx, "missing")
+	s.Nil(err)
+	s.Empty(items)
+
+	// Unknown items are ignored by the bulk operations
+	s.Nil(s.Repo.UpdateTodos(ctx, &todo.Todo{Id: "missing", Title: "title"}))
+	deleted, err := s.Repo.DeleteTodos(ctx, storage.Filter{IDs: []string{"missing"}})
+	s.Nil(err)
+	s.Equal(0, deleted)
+	count, err := s.Repo.CountTodos(ctx, storage.Filter{})
+	s.Nil(err)
+	s.Equal(0, count)
+
+	_, err = s.Repo.RunningTimeEntry(ctx, "nobody")
+	s.Equal(storage.ErrNotFound, err)
+	entries, err := s.Repo.ListTimeEntries(ctx, "missing")
+	s.Nil(err)
+	s.Empty(entries)
+	schemas, err := s.Repo.CustomFieldSchemas(ctx, "missing")
+	s.Nil(err)
+	s.Empty(schemas)
+	_, err = s.Repo.GetTemplate(ctx, "missing")
+	s.Equal(storage.ErrNotFound, err)
+	s.Equal(storage.ErrNotFound, s.Repo.DeleteTemplate(ctx, "missing"))
+}
+
+func (s *Suite) TestUpdateTodos() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx,
+		&todo.Todo{Id: "1", Title: "a", CreatedAt: at(0), ParentId: "p"},
+		&todo.Todo{Id: "2", Title: "b", CreatedAt: at(1), Tags: []string{"x"}},
+		&todo.Todo{Id: "3", Title: "c", CreatedAt: at(2)},
+	))
+	s.Nil(s.Repo.AddTrackedSeconds(ctx, "1", 30))
+	s.Nil(s.Repo.AddTrackedSeconds(ctx, "1", 15))
+
+	updated := &todo.Todo{
+		Id:           "1",
+		Title:        "updated",
+		Description:  "description",
+		Completed:    true,
+		UpdatedAt:    at(10),
+		Status:       "done",
+		Tags:         []string{"y"},
+		List:         "home",
+		CustomFields: map[string]string{"k": "v"},
+		DueAt:        at(20),
+		Recurrence:   "FREQ=WEEKLY",
+	}
+	s.Nil(s.Repo.UpdateTodos(ctx, updated, &todo.Todo{Id: "2", Title: "b2", CreatedAt: at(1)}, &todo.Todo{Id: "missing"}))
+
+	got, err := s.Repo.GetTodo(ctx, "1")
+	s.Nil(err)
+	// The creation date, parent and tracked time are not written
+	updated.CreatedAt = at(0)
+	updated.ParentId = "p"
+	updated.TrackedSeconds = 45
+	s.Equal(updated, got)
+	got, err = s.Repo.GetTodo(ctx, "2")
+	s.Nil(err)
+	s.Equal("b2", got.Title)
+	s.Empty(got.Tags)
+	got, err = s.Repo.GetTodo(ctx, "3")
+	s.Nil(err)
+	s.Equal("c", got.Title)
+	s.Equal([]string{"1", "2", "3"}, s.ids(storage.Filter{}))
+}
+
+func (s *Suite) TestListTodos() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx,
+		&todo.Todo{Id: "1", CreatedAt: at(0), Completed: true, Status: "done", List: "work"},
+		&todo.Todo{Id: "2", CreatedAt: at(1), List: "work", CustomFields: map[string]string{"pri": "A"}},
+		&todo.Todo{Id: "3", CreatedAt: at(2), ParentId: "2", CustomFields: map[string]string{"pri": "B"}},
+		&todo.Todo{Id: "4", CreatedAt: at(3), Status: "todo", ParentId: "2"},
+	))
+
+	filters := []struct {
+		filter storage.Filter
+		ids    []string
+	}{
+		{storage.Filter{}, []string{"1", "2", "3", "4"}},
+		{storage.Filter{NotCompleted: true}, []string{"2", "3", "4"}},
+		{storage.Filter{OnlyCompleted: true}, []string{"1"}},
+		{storage.Filter{Status: "todo"}, []string{"4"}},
+		{storage.Filter{List: "work", NotCompleted: true}, []string{"2"}},
+		{storage.Filter{ParentIDs: []string{"2"}}, []string{"3", "4"}},
+		{storage.Filter{CustomFields: [][2]string{{"pri", "B"}}}, []string{"3"}},
+		{storage.Filter{IDs: []string{"4", "1", "missing"}}, []string{"1", "4"}},
+		{storage.Filter{IDs: []string{}}, []string{}},
+		{storage.Filter{ParentIDs: []string{}}, []string{}},
+		{storage.Filter{NotCompleted: true, Limit: 2}, []string{"2", "3"}},
+	}
+	for _, f := range filters {
+		s.Equal(f.ids, s.ids(f.filter), "%+v", f.filter)
+		if f.filter.Limit == 0 {
+			count, err := s.Repo.CountTodos(ctx, f.filter)
+			s.Nil(err)
+			s.Equal(len(f.ids), count, "%+v", f.filter)
+		}
+	}
+}
+
+func (s *Suite) TestListTodosOrder() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx,
+		&todo.Todo{Id: "c", Title: "banana", CreatedAt: at(2), Status: "todo", CustomFields: map[string]string{"rank": "2"}},
+		&todo.Todo{Id: "a", Title: "cherry", CreatedAt: at(0), UpdatedAt: at(5)},
+		&todo.Todo{Id: "d", Title: "apple", CreatedAt: at(3), Status: "done", UpdatedAt: at(4)},
+		&todo.Todo{Id: "b", Title: "date", CreatedAt: at(1), CustomFields: map[string]string{"rank": "1"}},
+	))
+
+	orders := []struct {
+		order storage.Order
+		ids   []string
+	}{
+		{storage.Order{}, []string{"a", "b", "c", "d"}},
+		{storage.Order{Field: "created_at", Desc: true}, []string{"d", "c", "b", "a"}},
+		{storage.Order{Field: "id"}, []string{"a", "b", "c", "d"}},
+		{storage.Order{Field: "title"}, []string{"d", "c", "a", "b"}},
+		{storage.Order{Field: "title", Desc: true}, []string{"b", "a", "c", "d"}},
+		// Missing values come last in ascending order and first in
+		// descending order, as NULL does
+		{storage.Order{Field: "status"}, []string{"d", "c", "a", "b"}},
+		{storage.Order{Field: "updated_at", Desc: true}, []string{"c", "b", "a", "d"}},
+		{storage.Order{Field: "custom_fields.rank"}, []string{"b", "c", "a", "d"}},
+	}
+	for _, o := range orders {
+		s.Equal(o.ids, s.ids(storage.Filter{Order: o.order}), "%+v", o.order)
+	}
+
+	// AfterID pages through the items sorted by id
+	s.Equal([]string{"b", "c"}, s.ids(storage.Filter{AfterID: "a", Order: storage.Order{Field: "id"}, Limit: 2}))
+	s.Equal([]string{"d"}, s.ids(storage.Filter{AfterID: "c", Order: storage.Order{Field: "id"}, Limit: 2}))
+}
+
+func (s *Suite) TestDeleteTodos() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx,
+		&todo.Todo{Id: "1", CreatedAt: at(0), Completed: true},
+		&todo.Todo{Id: "2", CreatedAt: at(1), Completed: true},
+		&todo.Todo{Id: "3", CreatedAt: at(2)},
+	))
+	s.Nil(s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e1", TodoId: "1", UserId: "u", StartedAt: at(0), StoppedAt: at(60), DurationSeconds: 60}))
+	s.Nil(s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e3", TodoId: "3", UserId: "u", StartedAt: at(0), StoppedAt: at(60), DurationSeconds: 60}))
+
+	deleted, err := s.Repo.DeleteTodos(ctx, storage.Filter{OnlyCompleted: true})
+	s.Nil(err)
+	s.Equal(2, deleted)
+	s.Equal([]string{"3"}, s.ids(storage.Filter{}))
+
+	// The time entries of the deleted items are deleted with them
+	entries, err := s.Repo.ListTimeEntries(ctx, "1")
+	s.Nil(err)
+	s.Empty(entries)
+	entries, err = s.Repo.ListTimeEntries(ctx, "3")
+	s.Nil(err)
+	s.Len(entries, 1)
+
+	deleted, err = s.Repo.DeleteTodos(ctx, storage.Filter{IDs: []string{}})
+	s.Nil(err)
+	s.Equal(0, deleted)
+	deleted, err = s.Repo.DeleteTodos(ctx, storage.Filter{})
+	s.Nil(err)
+	s.Equal(1, deleted)
+}
+
+func (s *Suite) TestTransaction() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "kept"}))
+
+	failed := errors.New("failed")
+	err := s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		s.Nil(tx.CreateTodos(ctx, &todo.Todo{Id: "2", Title: "rolled back"}))
+		s.Nil(tx.UpdateTodos(ctx, &todo.Todo{Id: "1", Title: "changed"}))
+		// The transaction reads its own writes
+		item, err := tx.GetTodo(ctx, "1")
+		s.Nil(err)
+		s.Equal("changed", item.Title)
+		return failed
+	})
+	s.Equal(failed, err, "the error of fn is returned as is")
+	item, err := s.Repo.GetTodo(ctx, "1")
+	s.Nil(err)
+	s.Equal("kept", item.Title)
+	_, err = s.Repo.GetTodo(ctx, "2")
+	s.Equal(storage.ErrNotFound, err)
+
+	s.Nil(s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+		return tx.CreateTodos(ctx, &todo.Todo{Id: "2", Title: "committed"})
+	}))
+	item, err = s.Repo.GetTodo(ctx, "2")
+	s.Nil(err)
+	s.Equal("committed", item.Title)
+}
+
+// TestConcurrentUpdates checks that the items locked by LockTodos are not
+// modified by other transactions, so that no update is lost.
+func (s *Suite) TestConcurrentUpdates() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx, &todo.Todo{Id: "1", Title: "0"}))
+	n := s.Concurrency
+	if n == 0 {
+		n = 8
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*n)
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			errs <- s.Repo.Transaction(ctx, func(tx storage.Repository) error {
+				items, err := tx.LockTodos(ctx, "1")
+				if err != nil || len(items) != 1 {
+					return errors.New("could not lock the item")
+				}
+				count, err := strconv.Atoi(items[0].Title)
+				if err != nil {
+					return err
+				}
+				items[0].Title = strconv.Itoa(count + 1)
+				return tx.UpdateTodos(ctx, items[0])
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			errs <- s.Repo.AddTrackedSeconds(ctx, "1", 10)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.Nil(err)
+	}
+
+	item, err := s.Repo.GetTodo(ctx, "1")
+	s.Nil(err)
+	s.Equal(strconv.Itoa(n), item.Title)
+	s.Equal(int64(10*n), item.TrackedSeconds)
+}
+
+func (s *Suite) TestTimeEntries() {
+	ctx := context.Background()
+	s.Nil(s.Repo.CreateTodos(ctx, &todo.Todo{Id: "1"}, &todo.Todo{Id: "2"}))
+	s.Nil(s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e2", TodoId: "1", UserId: "alice", StartedAt: at(100)}))
+	s.Nil(s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e1", TodoId: "1", UserId: "bob", StartedAt: at(0), StoppedAt: at(60), DurationSeconds: 60}))
+	// A user has one running entry at most
+	s.Equal(storage.ErrAlreadyExists, s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e3", TodoId: "2", UserId: "alice", StartedAt: at(200)}))
+	s.Equal(storage.ErrAlreadyExists, s.Repo.CreateTimeEntry(ctx, &todo.TimeEntry{Id: "e1", TodoId: "2", UserId: "carol", StartedAt: at(0), StoppedAt: at(1)}))
+
+	running, err := s.Repo.RunningTimeEntry(ctx, "alice")
+	s.Nil(err)
+	s.Equal("e2", running.Id)
+	running.StoppedAt = at(130)
+	running.DurationSeconds = 30
+	s.Nil(s.Repo.StopTimeEntry(ctx, running))
+	_, err = s.Repo.RunningTimeEntry(ctx, "alice")
+	s.Equal(storage.ErrNotFound, err)
+
+	entries, err := s.Repo.ListTimeEntries(ctx, "1")
+	s.Nil(err)
+	if s.Len(entries, 2) {
+		s.Equal("e1", entries[0].Id)
+		s.Equal(running, entries[1])
+	}
+	from, _ := types.TimestampFromProto(at(90))
+	to, _ := types.TimestampFromProto(at(120))
+	between, err := s.Repo.TimeEntriesBetween(ctx, from, to)
+	s.Nil(err)
+	if s.Len(between, 1) {
+		s.Equal("e2", between[0].Id)
+	}
+}
+
+func (s *Suite) TestCustomFieldSchemas() {
+	ctx := context.Background()
+	s.Nil(s.Repo.PutCustomFieldSchema(ctx, &todo.CustomFieldSchema{List: "work", Schema: `{"type": "object"}`, UpdatedAt: at(0)}))
+	s.Nil(s.Repo.PutCustomFieldSchema(ctx, &todo.CustomFieldSchema{List: "home", Schema: `{}`, UpdatedAt: at(0)}))
+	replaced := &todo.CustomFieldSchema{List: "work", Schema: `{"required": ["pri"]}`, UpdatedAt: at(1)}
+	s.Nil(s.Repo.PutCustomFieldSchema(ctx, replaced))
+
+	schemas, err := s.Repo.CustomFieldSchemas(ctx, "work", "missing")
+	s.Nil(err)
+	s.Equal([]*todo.CustomFieldSchema{replaced}, schemas)
+	schemas, err = s.Repo.ListCustomFieldSchemas(ctx)
+	s.Nil(err)
+	if s.Len(schemas, 2) {
+		s.Equal("home", schemas[0].List)
+		s.Equal(replaced, schemas[1])
+	}
+}
+
+func (s *Suite) TestTemplates() {
+	ctx := context.Background()
+	template := &todo.TodoTemplate{Id: "t2", Name: "release", List: "ops", Item: &todo.TodoTemplate_Item{
+		Title:    "release",
+		Subtasks: []*todo.TodoTemplate_Item{{Title: "tag", Tags: []string{"ci"}, DueOffsetSeconds: 3600}},
+	}}
+	s.Nil(s.Repo.CreateTemplate(ctx, template))
+	s.Nil(s.Repo.CreateTemplate(ctx, &todo.TodoTemplate{Id: "t1", Name: "onboarding"}))
+
+	got, err := s.Repo.GetTemplate(ctx, "t2")
+	s.Nil(err)
+	s.Equal(template.Name, got.Name)
+	s.Equal(template.List, got.List)
+	s.Equal(template.Item, got.Item)
+	templates, err := s.Repo.ListTemplates(ctx)
+	s.Nil(err)
+	if s.Len(templates, 2) {
+		s.Equal("onboarding", templates[0].Name)
+		s.Equal("release", templates[1].Name)
+	}
+
+	s.Nil(s.Repo.DeleteTemplate(ctx, "t2"))
+	_, err = s.Repo.GetTemplate(ctx, "t2")
+	s.Equal(storage.ErrNotFound, err)
+}
+
+func (s *Suite) TestEvents() {
+	ctx := context.Background()
+	events := []*todo.TodoEvent{
+		{TodoId: "1", Type: todo.TodoEvent_CREATED, Item: &todo.Todo{Id: "1", Title: "a"}},
+		{TodoId: "1", Type: todo.TodoEvent_UPDATED, Item: &todo.Todo{Id: "1", Title: "b"}},
+		{TodoId: "2", Type: todo.TodoEvent_CREATED, Item: &todo.Todo{Id: "2", Title: "c"}},
+	}
+	s.Nil(s.Repo.AppendEvents(ctx, events...))
+	s.True(events[0].Id < events[1].Id && events[1].Id < events[2].Id, "event IDs increase")
+
+	// Only the oldest event of each item is claimed
+	claimed, err := s.Repo.ClaimEvents(ctx, 10)
+	s.Nil(err)
+	if s.Len(claimed, 2) {
+		s.Equal(events[0].Id, claimed[0].Id)
+		s.Equal("a", claimed[0].Item.Title)
+		s.Equal(events[2].Id, claimed[1].Id)
+	}
+	s.Nil(s.Repo.DeleteEvents(ctx, events[0].Id, events[2].Id))
+	claimed, err = s.Repo.ClaimEvents(ctx, 10)
+	s.Nil(err)
+	if s.Len(claimed, 1) {
+		s.Equal(events[1].Id, claimed[0].Id)
+		s.Equal(todo.TodoEvent_UPDATED, claimed[0].Type)
+	}
+}
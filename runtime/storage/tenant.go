@@ -0,0 +1,33 @@
+package storage
+
+import "context"
+
+type tenantKey struct{}
+
+// WithTenant scopes the records read and written with ctx to a tenant. Only
+// the backends isolating tenants, such as postgres with a tenant role,
+// enforce it.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// Tenant returns the tenant of ctx, empty when it has none.
+func Tenant(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+type allTenantsKey struct{}
+
+// WithAllTenants lets the records of every tenant be read and written with
+// ctx, for the background work of the service such as relaying the outbox.
+// It must not be used for the requests of the tenants.
+func WithAllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allTenantsKey{}, true)
+}
+
+// AllTenants reports whether ctx spans all the tenants.
+func AllTenants(ctx context.Context) bool {
+	all, _ := ctx.Value(allTenantsKey{}).(bool)
+	return all
+}
@@ -0,0 +1,306 @@
+// Package todotxt reads and writes todo items in the todo.txt format
+// (https://github.com/todotxt/todo.txt).
+//
+// The text of a task, including its +project, @context and key:value
+// tokens, is kept verbatim as the title of the item so that exporting an
+// imported file gives it back unchanged: Import records the position of each
+// task and whether it had a creation date in reserved custom fields, Export
+// writes the tasks in that order and without the creation date given to
+// them by the server. The tokens are also mapped onto the fields of the item:
+//
+//   - x marks the item as completed
+//   - the priority is stored as the "pri" custom field
+//   - the creation date is the created_at of the item and the completion
+//     date its updated_at
+//   - the first +project is the list of the item
+//   - @contexts are the tags of the item
+//   - key:value extensions are custom fields, due:YYYY-MM-DD also sets due_at
+//
+// Items created through the API are exported with tokens for the fields
+// their title does not mention.
+package todotxt
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// PriorityField is the custom field holding the priority of an item.
+	PriorityField = "pri"
+	// DueField is the custom field holding the due date of an item.
+	DueField = "due"
+	// PositionField is the custom field holding the position of an imported
+	// task, the time of its import and its line, which orders the export.
+	PositionField = "todotxt_position"
+	// UndatedField is the custom field marking the imported tasks which had
+	// no creation date.
+	UndatedField = "todotxt_undated"
+
+	// batchSize is the number of items created per request on import.
+	batchSize = 100
+	// pageSize is the number of items listed per request on export.
+	pageSize = 1000
+)
+
+var (
+	priority  = regexp.MustCompile(`^\(([A-Z])\) `)
+	date      = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}) `)
+	extension = regexp.MustCompile(`^([A-Za-z0-9_]+):([^\s:/][^\s:]*)$`)
+)
+
+// Parse reads the todo items of a todo.txt file, blank lines are skipped.
+func Parse(r io.Reader) ([]*todo.Todo, error) {
+	var items []*todo.Todo
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		items = append(items, ParseLine(line))
+	}
+	return items, scanner.Err()
+}
+
+// ParseLine reads a single todo.txt task. Invalid dates are part of the text.
+func ParseLine(line string) *todo.Todo {
+	item := &todo.Todo{}
+	var pri string
+	if strings.HasPrefix(line, "x ") {
+		item.Completed = true
+		line = line[2:]
+	} else if m := priority.FindStringSubmatch(line); m != nil {
+		pri = m[1]
+		line = line[len(m[0]):]
+	}
+
+	var dates []*types.Timestamp
+	for len(dates) < 2 {
+		m := date.FindStringSubmatch(line)
+		if m == nil {
+			break
+		}
+		ts, err := parseDate(m[1])
+		if err != nil {
+			break
+		}
+		dates = append(dates, ts)
+		line = line[len(m[0]):]
+		// Only completed tasks have a completion date before the creation date
+		if !item.Completed {
+			break
+		}
+	}
+	switch {
+	case len(dates) == 2:
+		item.UpdatedAt, item.CreatedAt = dates[0], dates[1]
+	case len(dates) == 1:
+		item.CreatedAt = dates[0]
+	}
+
+	item.Title = line
+	for _, token := range strings.Fields(line) {
+		switch {
+		case len(token) > 1 && token[0] == '+':
+			if item.List == "" {
+				item.List = token[1:]
+			}
+		case len(token) > 1 && token[0] == '@':
+			item.Tags = append(item.Tags, token[1:])
+		default:
+			m := extension.FindStringSubmatch(token)
+			if m == nil {
+				continue
+			}
+			if item.CustomFields == nil {
+				item.CustomFields = map[string]string{}
+			}
+			item.CustomFields[m[1]] = m[2]
+			if m[1] == DueField {
+				if due, err := parseDate(m[2]); err == nil {
+					item.DueAt = due
+				}
+			}
+		}
+	}
+	if pri != "" {
+		if item.CustomFields == nil {
+			item.CustomFields = map[string]string{}
+		}
+		item.CustomFields[PriorityField] = pri
+	}
+	return item
+}
+
+// Format writes todo items in the todo.txt format, one per line.
+func Format(w io.Writer, items []*todo.Todo) error {
+	for _, item := range items {
+		if _, err := io.WriteString(w, FormatLine(item)+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FormatLine formats a todo item as a todo.txt task.
+func FormatLine(item *todo.Todo) string {
+	var parts []string
+	// Completed tasks have no priority, it is kept as an extension
+	pri := item.CustomFields[PriorityField]
+	if item.Completed || len(pri) != 1 || pri[0] < 'A' || pri[0] > 'Z' {
+		pri = ""
+	}
+	created := item.CreatedAt
+	if item.CustomFields[UndatedField] != "" {
+		created = nil
+	}
+	if item.Completed {
+		parts = append(parts, "x")
+		if item.UpdatedAt != nil && created != nil {
+			parts = append(parts, formatDate(item.UpdatedAt))
+		}
+	} else if pri != "" {
+		parts = append(parts, "("+pri+")")
+	}
+	if created != nil {
+		parts = append(parts, formatDate(created))
+	}
+	if item.Title != "" {
+		parts = append(parts, item.Title)
+	}
+
+	tokens := map[string]bool{}
+	projects := 0
+	for _, token := range strings.Fields(item.Title) {
+		tokens[token] = true
+		if len(token) > 1 && token[0] == '+' {
+			projects++
+		}
+	}
+	add := func(token string) {
+		if !tokens[token] {
+			tokens[token] = true
+			parts = append(parts, token)
+		}
+	}
+	if item.List != "" && projects == 0 {
+		add("+" + item.List)
+	}
+	for _, tag := range item.Tags {
+		add("@" + tag)
+	}
+	keys := make([]string, 0, len(item.CustomFields))
+	for k := range item.CustomFields {
+		if k == PriorityField && pri != "" || k == PositionField || k == UndatedField {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add(k + ":" + item.CustomFields[k])
+	}
+	if _, ok := item.CustomFields[DueField]; !ok && item.DueAt != nil {
+		add(DueField + ":" + formatDate(item.DueAt))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Import creates the todo items of a todo.txt file and returns their IDs.
+// Items are created in batches, an error leaves the previous batches created.
+func Import(ctx context.Context, client todo.TodoServiceClient, r io.Reader) ([]string, error) {
+	items, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixNano()
+	for i, item := range items {
+		if item.CustomFields == nil {
+			item.CustomFields = map[string]string{}
+		}
+		item.CustomFields[PositionField] = fmt.Sprintf("%019d.%06d", now, i)
+		if item.CreatedAt == nil {
+			item.CustomFields[UndatedField] = "true"
+		}
+	}
+	var ids []string
+	for len(items) > 0 {
+		n := batchSize
+		if n > len(items) {
+			n = len(items)
+		}
+		res, err := client.CreateTodos(ctx, &todo.CreateTodosRequest{Items: items[:n]})
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, res.Ids...)
+		items = items[n:]
+	}
+	return ids, nil
+}
+
+// Export writes every todo item in the todo.txt format, listed page by page.
+// The imported tasks keep the order of their files, which are ordered with
+// the other items by the time they were imported or created.
+func Export(ctx context.Context, client todo.TodoServiceClient, w io.Writer) error {
+	var items []*todo.Todo
+	after := ""
+	for {
+		res, err := client.ListTodo(ctx, &todo.ListTodoRequest{OrderBy: "id", AfterId: after, Limit: pageSize})
+		if err != nil {
+			return err
+		}
+		items = append(items, res.Items...)
+		if len(res.Items) < pageSize {
+			break
+		}
+		after = res.Items[len(res.Items)-1].Id
+	}
+	keys := make(map[*todo.Todo]string, len(items))
+	for _, item := range items {
+		keys[item] = position(item)
+	}
+	sort.SliceStable(items, func(i, j int) bool { return keys[items[i]] < keys[items[j]] })
+	return Format(w, items)
+}
+
+// position returns the key ordering an item on export: the position
+// recorded on import, or the creation time of the items created otherwise.
+func position(item *todo.Todo) string {
+	if p := item.CustomFields[PositionField]; p != "" {
+		return p
+	}
+	var nanos int64
+	if t, err := types.TimestampFromProto(item.CreatedAt); err == nil {
+		nanos = t.UnixNano()
+	}
+	return fmt.Sprintf("%019d", nanos)
+}
+
+func parseDate(s string) (*types.Timestamp, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q", s)
+	}
+	return types.TimestampProto(t)
+}
+
+func formatDate(ts *types.Timestamp) string {
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(dateLayout)
+}
@@ -0,0 +1,117 @@
+package todotxt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+const file = `(A) Call Mom +Family @phone
+x 2011-03-03 2011-03-01 Review Tim's pull request +TodoTxtTouch @github pri:B
+2011-03-02 Document +TodoTxt task format due:2011-03-10
+Post signup link http://example.com/signup @web
+x Pay the bills
+`
+
+func TestRoundTrip(t *testing.T) {
+	items, err := Parse(strings.NewReader(file))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(items))
+
+	assert.Equal(t, "Call Mom +Family @phone", items[0].Title)
+	assert.Equal(t, "Family", items[0].List)
+	assert.Equal(t, []string{"phone"}, items[0].Tags)
+	assert.Equal(t, "A", items[0].CustomFields[PriorityField])
+
+	assert.True(t, items[1].Completed)
+	assert.Equal(t, int64(1299110400), items[1].UpdatedAt.Seconds)
+	assert.Equal(t, int64(1298937600), items[1].CreatedAt.Seconds)
+	assert.Equal(t, "B", items[1].CustomFields[PriorityField])
+
+	assert.Equal(t, int64(1299715200), items[2].DueAt.Seconds)
+	assert.Nil(t, items[3].CustomFields)
+
+	var buf bytes.Buffer
+	assert.Nil(t, Format(&buf, items))
+	assert.Equal(t, file, buf.String())
+}
+
+func TestFormatLine(t *testing.T) {
+	item := &todo.Todo{
+		Title:        "Write report",
+		Completed:    true,
+		CreatedAt:    &types.Timestamp{Seconds: 1298937600},
+		UpdatedAt:    &types.Timestamp{Seconds: 1299110400},
+		List:         "work",
+		Tags:         []string{"office"},
+		CustomFields: map[string]string{PriorityField: "C", "ticket": "T-1"},
+		DueAt:        &types.Timestamp{Seconds: 1299715200},
+	}
+	assert.Equal(t, "x 2011-03-03 2011-03-01 Write report +work @office pri:C ticket:T-1 due:2011-03-10", FormatLine(item))
+	assert.Equal(t, item.Title, ParseLine(FormatLine(item)).Title[:len(item.Title)])
+}
+
+// server stores the items like the todo service: the items created together
+// share their creation time and are listed by ID.
+type server struct {
+	todo.TodoServiceClient
+	items  []*todo.Todo
+	now    int64
+	listed int
+}
+
+func (s *server) CreateTodos(ctx context.Context, req *todo.CreateTodosRequest, opts ...grpc.CallOption) (*todo.CreateTodosResponse, error) {
+	s.now += 3600
+	res := &todo.CreateTodosResponse{}
+	for _, item := range req.Items {
+		item = proto.Clone(item).(*todo.Todo)
+		// IDs do not follow the order of creation
+		item.Id = fmt.Sprintf("%08x", (len(s.items)*7919)%65536)
+		if item.CreatedAt == nil {
+			item.CreatedAt = &types.Timestamp{Seconds: s.now}
+		}
+		s.items = append(s.items, item)
+		res.Ids = append(res.Ids, item.Id)
+	}
+	return res, nil
+}
+
+func (s *server) ListTodo(ctx context.Context, req *todo.ListTodoRequest, opts ...grpc.CallOption) (*todo.ListTodoResponse, error) {
+	s.listed++
+	sort.Slice(s.items, func(i, j int) bool { return s.items[i].Id < s.items[j].Id })
+	res := &todo.ListTodoResponse{}
+	for _, item := range s.items {
+		if item.Id > req.AfterId && len(res.Items) < int(req.Limit) {
+			res.Items = append(res.Items, proto.Clone(item).(*todo.Todo))
+		}
+	}
+	return res, nil
+}
+
+func TestImportExport(t *testing.T) {
+	ctx := context.Background()
+	s := &server{now: 1546300800}
+	_, err := Import(ctx, s, strings.NewReader(file))
+	assert.Nil(t, err)
+	var lines []string
+	for i := 0; i < 2*pageSize; i++ {
+		lines = append(lines, fmt.Sprintf("task %d", i))
+	}
+	second := strings.Join(lines, "\n") + "\n"
+	_, err = Import(ctx, s, strings.NewReader(second))
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	assert.Nil(t, Export(ctx, s, &buf))
+	assert.Equal(t, file+second, buf.String())
+	assert.Equal(t, 3, s.listed)
+}
@@ -0,0 +1,289 @@
+// Package validation checks the requests of the TodoService before they
+// reach the store and reports every violation as a google.rpc.BadRequest.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/gofunct/gotasks/runtime/markdown"
+	"github.com/gofunct/gotasks/runtime/storage"
+	"github.com/satori/go.uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits enforced on the requests.
+const (
+	MaxBatchSize          = 100
+	MaxListLimit          = 1000
+	MaxTitleLength        = 200
+	MaxDescriptionLength  = 10000
+	MaxTags               = 20
+	MaxTagLength          = 50
+	MaxListLength         = 100
+	MaxCustomFields       = 50
+	MaxCustomFieldLength  = 1000
+	MaxUserIDLength       = 100
+	MaxCustomFieldFilters = 20
+	MaxCalendarSize       = 1 << 20
+	MaxTodoTxtSize        = 1 << 20
+	MaxQuickAddLength     = 500
+	MaxCalendarUIDLength  = 255
+)
+
+// Violations collects the field violations of a request.
+type Violations []*errdetails.BadRequest_FieldViolation
+
+// Add records a violation of field.
+func (v *Violations) Add(field, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err returns an InvalidArgument error carrying the violations
+// as a BadRequest detail, or nil when there are none.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	msg := "Invalid request: " + v[0].Field + ": " + v[0].Description
+	if len(v) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(v)-1)
+	}
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor rejects invalid requests with InvalidArgument
+// before the interceptors after it. The Store validates its requests too,
+// for the servers embedding it without the interceptor.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Request(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Request validates a TodoService request. Unknown requests are accepted.
+func Request(req interface{}) error {
+	var v Violations
+	switch r := req.(type) {
+	case *todo.CreateTodoRequest:
+		if r.Item == nil {
+			v.Add("item", "is required")
+			break
+		}
+		v = append(v, Todo("item.", r.Item, false)...)
+	case *todo.CreateTodosRequest:
+		batch(&v, "items", len(r.Items))
+		for i, item := range r.Items {
+			field := fmt.Sprintf("items[%d]", i)
+			if item == nil {
+				v.Add(field, "is required")
+				continue
+			}
+			v = append(v, Todo(field+".", item, false)...)
+		}
+	case *todo.GetTodoRequest:
+		id(&v, "id", r.Id)
+		renderView(&v, r.Render)
+		consistency(&v, r.Consistency)
+	case *todo.ListTodoRequest:
+		if r.Limit < 0 || r.Limit > MaxListLimit {
+			v.Add("limit", "must be between 0 and %d", MaxListLimit)
+		}
+		maxLength(&v, "list", r.List, MaxListLength)
+		if len(r.CustomFieldFilters) > MaxCustomFieldFilters {
+			v.Add("custom_field_filters", "must not have more than %d filters", MaxCustomFieldFilters)
+		}
+		if r.AfterId != "" {
+			id(&v, "after_id", r.AfterId)
+			if r.OrderBy != "id" {
+				v.Add("after_id", "requires order_by=id")
+			}
+		}
+		renderView(&v, r.Render)
+		consistency(&v, r.Consistency)
+	case *todo.DeleteTodoRequest:
+		id(&v, "id", r.Id)
+	case *todo.DeleteTodosRequest:
+		batch(&v, "ids", len(r.Ids))
+		for i, s := range r.Ids {
+			id(&v, fmt.Sprintf("ids[%d]", i), s)
+		}
+	case *todo.DeleteTodosByFilterRequest:
+		maxLength(&v, "list", r.List, MaxListLength)
+		if len(r.CustomFieldFilters) > MaxCustomFieldFilters {
+			v.Add("custom_field_filters", "must not have more than %d filters", MaxCustomFieldFilters)
+		}
+	case *todo.UpdateTodoRequest:
+		if r.Item == nil {
+			v.Add("item", "is required")
+			break
+		}
+		v = append(v, Todo("item.", r.Item, true)...)
+	case *todo.UpdateTodosRequest:
+		// Invalid items are reported in the per-item results
+		batch(&v, "items", len(r.Items))
+		for i, item := range r.Items {
+			if item == nil {
+				v.Add(fmt.Sprintf("items[%d]", i), "is required")
+			}
+		}
+	case *todo.StartTimerRequest:
+		id(&v, "todo_id", r.TodoId)
+		required(&v, "user_id", r.UserId, MaxUserIDLength)
+	case *todo.StopTimerRequest:
+		required(&v, "user_id", r.UserId, MaxUserIDLength)
+	case *todo.ListTimeEntriesRequest:
+		id(&v, "todo_id", r.TodoId)
+	case *todo.RegisterCustomFieldSchemaRequest:
+		required(&v, "list", r.List, MaxListLength)
+		if r.Schema == "" {
+			v.Add("schema", "is required")
+		}
+	case *todo.GetCustomFieldSchemaRequest:
+		required(&v, "list", r.List, MaxListLength)
+	case *todo.CloneTodoRequest:
+		id(&v, "id", r.Id)
+	case *todo.CreateTodoTemplateRequest:
+		if r.Template == nil {
+			v.Add("template", "is required")
+			break
+		}
+		required(&v, "template.name", r.Template.Name, MaxTitleLength)
+		maxLength(&v, "template.list", r.Template.List, MaxListLength)
+		if r.Template.Item == nil {
+			v.Add("template.item", "is required")
+			break
+		}
+		if n := templateItem(&v, "template.item.", r.Template.Item); n > MaxBatchSize {
+			v.Add("template.item", "must not have more than %d items with its subtasks", MaxBatchSize)
+		}
+	case *todo.GetTodoTemplateRequest:
+		id(&v, "id", r.Id)
+	case *todo.DeleteTodoTemplateRequest:
+		id(&v, "id", r.Id)
+	case *todo.InstantiateTemplateRequest:
+		id(&v, "id", r.Id)
+	case *todo.QuickAddTodoRequest:
+		required(&v, "text", r.Text, MaxQuickAddLength)
+	case *todo.ImportTodosRequest:
+		if r.Calendar == "" {
+			v.Add("calendar", "is required")
+		} else if len(r.Calendar) > MaxCalendarSize {
+			v.Add("calendar", "must not be larger than %d bytes", MaxCalendarSize)
+		}
+	}
+	return v.Err()
+}
+
+// Todo returns the violations of a todo item, its fields prefixed by prefix.
+// The ID is only checked for existing items.
+func Todo(prefix string, item *todo.Todo, existing bool) Violations {
+	var v Violations
+	if existing {
+		id(&v, prefix+"id", item.Id)
+	}
+	required(&v, prefix+"title", item.Title, MaxTitleLength)
+	maxLength(&v, prefix+"description", item.Description, MaxDescriptionLength)
+	if len(item.Tags) > MaxTags {
+		v.Add(prefix+"tags", "must not have more than %d tags", MaxTags)
+	}
+	for i, tag := range item.Tags {
+		required(&v, fmt.Sprintf("%stags[%d]", prefix, i), tag, MaxTagLength)
+	}
+	maxLength(&v, prefix+"list", item.List, MaxListLength)
+	maxLength(&v, prefix+"ical_uid", item.IcalUid, MaxCalendarUIDLength)
+	if len(item.CustomFields) > MaxCustomFields {
+		v.Add(prefix+"custom_fields", "must not have more than %d fields", MaxCustomFields)
+	}
+	keys := make([]string, 0, len(item.CustomFields))
+	for k := range item.CustomFields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		maxLength(&v, prefix+"custom_fields."+k, item.CustomFields[k], MaxCustomFieldLength)
+	}
+	return v
+}
+
+// templateItem records the violations of a template item and its
+// subtasks, and returns the number of items.
+func templateItem(v *Violations, prefix string, item *todo.TodoTemplate_Item) int {
+	*v = append(*v, Todo(prefix, &todo.Todo{
+		Title:        item.Title,
+		Description:  item.Description,
+		Tags:         item.Tags,
+		CustomFields: item.CustomFields,
+	}, false)...)
+	n := 1
+	for i, subtask := range item.Subtasks {
+		field := fmt.Sprintf("%ssubtasks[%d]", prefix, i)
+		if subtask == nil {
+			v.Add(field, "is required")
+			continue
+		}
+		n += templateItem(v, field+".", subtask)
+	}
+	return n
+}
+
+func renderView(v *Violations, render string) {
+	if render != "" && render != markdown.RenderHTML {
+		v.Add("render", "must be empty or %s", markdown.RenderHTML)
+	}
+}
+
+func consistency(v *Violations, consistency string) {
+	if consistency != "" && consistency != storage.ConsistencyStrong {
+		v.Add("consistency", "must be empty or %s", storage.ConsistencyStrong)
+	}
+}
+
+func id(v *Violations, field, s string) {
+	if s == "" {
+		v.Add(field, "is required")
+		return
+	}
+	if _, err := uuid.FromString(s); err != nil {
+		v.Add(field, "must be a UUID")
+	}
+}
+
+func required(v *Violations, field, s string, max int) {
+	if s == "" {
+		v.Add(field, "is required")
+		return
+	}
+	maxLength(v, field, s, max)
+}
+
+func maxLength(v *Violations, field, s string, max int) {
+	if utf8.RuneCountInString(s) > max {
+		v.Add(field, "must not be longer than %d characters", max)
+	}
+}
+
+func batch(v *Violations, field string, n int) {
+	switch {
+	case n == 0:
+		v.Add(field, "must not be empty")
+	case n > MaxBatchSize:
+		v.Add(field, "must not have more than %d elements", MaxBatchSize)
+	}
+}
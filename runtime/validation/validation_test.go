@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gofunct/gotasks/api/todo/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func fields(t *testing.T, err error) []string {
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, d := range st.Details() {
+		for _, v := range d.(*errdetails.BadRequest).FieldViolations {
+			fields = append(fields, v.Field)
+		}
+	}
+	return fields
+}
+
+func TestRequest(t *testing.T) {
+	assert.Nil(t, Request(&todo.CreateTodoRequest{Item: &todo.Todo{Title: "item_1"}}))
+	assert.Equal(t, []string{"item"}, fields(t, Request(&todo.CreateTodoRequest{})))
+	assert.Equal(t, []string{"item.title", "item.tags[0]"}, fields(t, Request(&todo.CreateTodoRequest{
+		Item: &todo.Todo{Tags: []string{""}},
+	})))
+	assert.Equal(t, []string{"item.description"}, fields(t, Request(&todo.CreateTodoRequest{
+		Item: &todo.Todo{Title: "item_1", Description: strings.Repeat("a", MaxDescriptionLength+1)},
+	})))
+	assert.Equal(t, []string{"items[1].title"}, fields(t, Request(&todo.CreateTodosRequest{
+		Items: []*todo.Todo{{Title: "item_1"}, {}},
+	})))
+	assert.Equal(t, "ids", fields(t, Request(&todo.DeleteTodosRequest{
+		Ids: make([]string, MaxBatchSize+1),
+	}))[0])
+	assert.Equal(t, []string{"id"}, fields(t, Request(&todo.GetTodoRequest{Id: "1"})))
+	assert.Nil(t, Request(&todo.ListTodoRequest{Consistency: "strong"}))
+	assert.Equal(t, []string{"consistency"}, fields(t, Request(&todo.ListTodoRequest{Consistency: "eventual"})))
+	assert.Nil(t, Request(&todo.ListTodoRequest{AfterId: "34d63bd4-56b3-4795-80d4-86e5db6fa0b5", OrderBy: "id"}))
+	assert.Equal(t, []string{"after_id"}, fields(t, Request(&todo.ListTodoRequest{AfterId: "34d63bd4-56b3-4795-80d4-86e5db6fa0b5"})))
+	assert.Equal(t, []string{"item.id"}, fields(t, Request(&todo.UpdateTodoRequest{
+		Item: &todo.Todo{Title: "item_1"},
+	})))
+}
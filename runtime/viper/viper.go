@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"os"
+	"time"
 )
 
 var log = logging.LogViper()
@@ -15,6 +16,18 @@ func VString(key string) string {
 	return s
 }
 
+func VStrings(key string) []string {
+	return viper.GetStringSlice(key)
+}
+
+func VInt(key string) int {
+	return viper.GetInt(key)
+}
+
+func VDuration(key string) time.Duration {
+	return viper.GetDuration(key)
+}
+
 func Viperize() func(cmd *cobra.Command, args []string) error {
 
 	return func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,126 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Workflow describes the statuses a todo item can be in and
+// the transitions allowed between them.
+type Workflow struct {
+	// Statuses lists every allowed status.
+	Statuses []string `mapstructure:"statuses"`
+	// Initial is the status given to new items.
+	Initial string `mapstructure:"initial"`
+	// Terminal lists the statuses for which an item is considered completed.
+	// The first one is used when a client only sets the completed flag.
+	Terminal []string `mapstructure:"terminal"`
+	// Transitions maps a status to the statuses it may move to.
+	Transitions map[string][]string `mapstructure:"transitions"`
+}
+
+// Default returns the workflow matching the legacy completed flag:
+// an item is either todo or done and can move freely between both.
+func Default() *Workflow {
+	return &Workflow{
+		Statuses: []string{"todo", "done"},
+		Initial:  "todo",
+		Terminal: []string{"done"},
+		Transitions: map[string][]string{
+			"todo": {"done"},
+			"done": {"todo"},
+		},
+	}
+}
+
+// FromViper loads the workflow from the "workflow" section of the config,
+// falling back to the default workflow when the section is missing.
+func FromViper() (*Workflow, error) {
+	if !viper.IsSet("workflow") {
+		return Default(), nil
+	}
+	var w Workflow
+	if err := viper.UnmarshalKey("workflow", &w); err != nil {
+		return nil, err
+	}
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Validate checks that the workflow only references declared statuses.
+func (w *Workflow) Validate() error {
+	if len(w.Statuses) == 0 {
+		return fmt.Errorf("workflow: no statuses declared")
+	}
+	if !w.Has(w.Initial) {
+		return fmt.Errorf("workflow: unknown initial status %q", w.Initial)
+	}
+	if len(w.Terminal) == 0 {
+		return fmt.Errorf("workflow: no terminal status declared")
+	}
+	for _, s := range w.Terminal {
+		if !w.Has(s) {
+			return fmt.Errorf("workflow: unknown terminal status %q", s)
+		}
+	}
+	for from, tos := range w.Transitions {
+		if !w.Has(from) {
+			return fmt.Errorf("workflow: unknown status %q in transitions", from)
+		}
+		for _, to := range tos {
+			if !w.Has(to) {
+				return fmt.Errorf("workflow: unknown status %q in transitions from %q", to, from)
+			}
+		}
+	}
+	return nil
+}
+
+// Has reports whether status is declared in the workflow.
+func (w *Workflow) Has(status string) bool {
+	return contains(w.Statuses, status)
+}
+
+// IsTerminal reports whether an item in the given status is completed.
+func (w *Workflow) IsTerminal(status string) bool {
+	return contains(w.Terminal, status)
+}
+
+// Done returns the status used to complete an item.
+func (w *Workflow) Done() string {
+	return w.Terminal[0]
+}
+
+// CanTransition reports whether an item may move from one status to another.
+// Staying in the same status is always allowed.
+func (w *Workflow) CanTransition(from, to string) bool {
+	if from == to {
+		return true
+	}
+	return contains(w.Transitions[from], to)
+}
+
+// Resolve returns the status of an item stored before statuses existed,
+// based on its completed flag.
+func (w *Workflow) Resolve(status string, completed bool) string {
+	switch {
+	case status != "":
+		return status
+	case completed:
+		return w.Done()
+	default:
+		return w.Initial
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
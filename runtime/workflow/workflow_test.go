@@ -0,0 +1,83 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func review() *Workflow {
+	return &Workflow{
+		Statuses: []string{"todo", "doing", "review", "done", "cancelled"},
+		Initial:  "todo",
+		Terminal: []string{"done", "cancelled"},
+		Transitions: map[string][]string{
+			"todo":   {"doing", "cancelled"},
+			"doing":  {"review", "todo"},
+			"review": {"done", "doing"},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Default().Validate())
+	assert.Nil(t, review().Validate())
+
+	for name, tc := range map[string]struct {
+		edit func(w *Workflow)
+		err  string
+	}{
+		"no statuses":      {func(w *Workflow) { w.Statuses = nil }, `workflow: no statuses declared`},
+		"unknown initial":  {func(w *Workflow) { w.Initial = "new" }, `workflow: unknown initial status "new"`},
+		"no terminal":      {func(w *Workflow) { w.Terminal = nil }, `workflow: no terminal status declared`},
+		"unknown terminal": {func(w *Workflow) { w.Terminal = []string{"done", "closed"} }, `workflow: unknown terminal status "closed"`},
+		"unknown from":     {func(w *Workflow) { w.Transitions["blocked"] = []string{"todo"} }, `workflow: unknown status "blocked" in transitions`},
+		"unknown to":       {func(w *Workflow) { w.Transitions["todo"] = []string{"blocked"} }, `workflow: unknown status "blocked" in transitions from "todo"`},
+	} {
+		w := review()
+		tc.edit(w)
+		err := w.Validate()
+		if assert.NotNil(t, err, name) {
+			assert.Equal(t, tc.err, err.Error(), name)
+		}
+	}
+}
+
+func TestCanTransition(t *testing.T) {
+	w := review()
+	for _, tc := range []struct {
+		from, to string
+		want     bool
+	}{
+		{"todo", "doing", true},
+		{"todo", "todo", true},
+		{"done", "done", true},
+		{"todo", "done", false},
+		{"review", "done", true},
+		{"done", "todo", false},
+		{"todo", "unknown", false},
+		{"unknown", "todo", false},
+	} {
+		assert.Equal(t, tc.want, w.CanTransition(tc.from, tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	w := review()
+	for _, tc := range []struct {
+		status    string
+		completed bool
+		want      string
+	}{
+		{"", false, "todo"},
+		{"", true, "done"},
+		{"review", false, "review"},
+		// The status wins over the completed flag
+		{"doing", true, "doing"},
+	} {
+		assert.Equal(t, tc.want, w.Resolve(tc.status, tc.completed), "%q %v", tc.status, tc.completed)
+	}
+	assert.True(t, w.IsTerminal("cancelled"))
+	assert.False(t, w.IsTerminal("review"))
+	assert.Equal(t, "done", w.Done())
+}